@@ -0,0 +1,14 @@
+resource "vkcs_blockstorage_volume_transfer" "data" {
+  volume_id = vkcs_blockstorage_volume.data.id
+  name      = "data-transfer-tf-example"
+}
+
+# Pass transfer ID and auth key to the target project
+output "transfer_id" {
+  value = vkcs_blockstorage_volume_transfer.data.id
+}
+
+output "transfer_auth_key" {
+  value     = vkcs_blockstorage_volume_transfer.data.auth_key
+  sensitive = true
+}
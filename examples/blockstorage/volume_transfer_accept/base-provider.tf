@@ -0,0 +1,5 @@
+# Provider configured for the target project of the transfer
+provider "vkcs" {
+  alias      = "target"
+  project_id = "<target project ID>"
+}
@@ -0,0 +1,4 @@
+resource "vkcs_blockstorage_volume_transfer" "data" {
+  volume_id = vkcs_blockstorage_volume.data.id
+  name      = "data-transfer-tf-example"
+}
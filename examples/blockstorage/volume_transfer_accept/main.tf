@@ -0,0 +1,5 @@
+resource "vkcs_blockstorage_volume_transfer_accept" "data" {
+  provider    = vkcs.target
+  transfer_id = vkcs_blockstorage_volume_transfer.data.id
+  auth_key    = vkcs_blockstorage_volume_transfer.data.auth_key
+}
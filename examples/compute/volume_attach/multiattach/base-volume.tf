@@ -0,0 +1,8 @@
+resource "vkcs_blockstorage_volume" "shared" {
+  name              = "shared-tf-example"
+  size              = 10
+  availability_zone = "GZ1"
+  # The volume type must support multiattach
+  volume_type = "ceph-ssd"
+  multiattach = true
+}
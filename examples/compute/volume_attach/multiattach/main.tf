@@ -0,0 +1,7 @@
+# Attach the same multiattach volume to every node, e.g. for a
+# clustered filesystem like OCFS2 or GFS2
+resource "vkcs_compute_volume_attach" "shared" {
+  count       = length(vkcs_compute_instance.nodes)
+  instance_id = vkcs_compute_instance.nodes[count.index].id
+  volume_id   = vkcs_blockstorage_volume.shared.id
+}
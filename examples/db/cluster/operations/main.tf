@@ -0,0 +1,34 @@
+resource "vkcs_db_cluster" "pg_cluster_operations" {
+  name = "pg-cluster-operations-tf-example"
+
+  availability_zone = "GZ1"
+  datastore {
+    type    = "postgresql"
+    version = "16"
+  }
+
+  cluster_size = 3
+
+  flavor_id = data.vkcs_compute_flavor.basic.id
+
+  volume_size = 10
+  volume_type = "ceph-ssd"
+
+  network {
+    uuid = vkcs_networking_network.db.id
+  }
+
+  # Change these values to restart instances one by one
+  # or to move the leader role to another instance.
+  restart_trigger    = "2024-01-15"
+  switchover_trigger = "2024-01-15"
+
+  depends_on = [
+    vkcs_networking_router_interface.db
+  ]
+}
+
+output "cluster_roles" {
+  value       = { for i in vkcs_db_cluster.pg_cluster_operations.instances : i.instance_id => i.role }
+  description = "Roles of the cluster instances."
+}
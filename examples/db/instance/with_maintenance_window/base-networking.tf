@@ -0,0 +1 @@
+../../../networking/main.tf
\ No newline at end of file
@@ -0,0 +1,29 @@
+resource "vkcs_db_instance" "pg_with_maintenance" {
+  name              = "pg-with-maintenance-tf-example"
+  availability_zone = "GZ1"
+  flavor_id         = data.vkcs_compute_flavor.basic.id
+
+  # Change version to a newer one to upgrade the instance in place
+  datastore {
+    type    = "postgresql"
+    version = "16"
+  }
+
+  network {
+    uuid = vkcs_networking_network.db.id
+  }
+
+  size        = 8
+  volume_type = "ceph-ssd"
+
+  # Perform maintenance on Sundays from 03:00 to 05:00 UTC
+  maintenance_window {
+    day        = "sun"
+    start_hour = 3
+    duration   = 2
+  }
+
+  depends_on = [
+    vkcs_networking_router_interface.db,
+  ]
+}
@@ -0,0 +1,24 @@
+resource "vkcs_db_instance" "mysql_with_logs" {
+  name              = "mysql-with-logs-tf-example"
+  availability_zone = "GZ1"
+  flavor_id         = data.vkcs_compute_flavor.basic.id
+
+  datastore {
+    type    = "mysql"
+    version = "8.0"
+  }
+
+  network {
+    uuid = vkcs_networking_network.db.id
+  }
+
+  size        = 8
+  volume_type = "ceph-ssd"
+
+  # Enable and publish slow query log of the instance
+  published_logs = ["slow_query"]
+
+  depends_on = [
+    vkcs_networking_router_interface.db,
+  ]
+}
@@ -0,0 +1 @@
+../../compute/flavor/name/main.tf
\ No newline at end of file
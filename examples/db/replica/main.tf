@@ -0,0 +1,32 @@
+resource "vkcs_db_instance" "source" {
+  name              = "source-tf-example"
+  availability_zone = "GZ1"
+  flavor_id         = data.vkcs_compute_flavor.basic.id
+  datastore {
+    type    = "mysql"
+    version = "8.0"
+  }
+  network {
+    uuid = vkcs_networking_network.db.id
+  }
+  size        = 8
+  volume_type = "ceph-ssd"
+  depends_on = [
+    vkcs_networking_router_interface.db,
+  ]
+}
+
+resource "vkcs_db_replica" "replica" {
+  name               = "replica-tf-example"
+  source_instance_id = vkcs_db_instance.source.id
+  # Replica has its own flavor, volume and network
+  availability_zone = "GZ1"
+  flavor_id         = data.vkcs_compute_flavor.basic.id
+  size              = 8
+  volume_type       = "ceph-ssd"
+  network {
+    uuid = vkcs_networking_network.db.id
+  }
+  # Set to true to detach the replica and make it a standalone instance
+  promote = false
+}
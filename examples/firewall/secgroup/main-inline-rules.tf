@@ -0,0 +1,28 @@
+resource "vkcs_networking_secgroup" "web" {
+  name        = "web-tf-example"
+  description = "web servers"
+
+  # Rules are authoritative: any other rules of the group are removed.
+  rule {
+    direction        = "ingress"
+    protocol         = "tcp"
+    port_range_min   = 443
+    port_range_max   = 443
+    remote_ip_prefix = "0.0.0.0/0"
+    description      = "https"
+  }
+
+  rule {
+    direction        = "ingress"
+    protocol         = "tcp"
+    port_range_min   = 22
+    port_range_max   = 22
+    remote_ip_prefix = "10.0.0.0/8"
+    description      = "ssh from internal networks"
+  }
+
+  rule {
+    direction   = "egress"
+    description = "all outgoing traffic"
+  }
+}
@@ -0,0 +1,13 @@
+resource "vkcs_images_image" "almalinux9" {
+  name = "almalinux9-tf-example"
+  # The image service downloads the image itself, so the image
+  # is neither downloaded to nor uploaded from the local host.
+  image_source_url = "https://repo.almalinux.org/almalinux/9/cloud/x86_64/images/AlmaLinux-9-GenericCloud-latest.x86_64.qcow2"
+  import_method    = "web-download"
+  container_format = "bare"
+  disk_format      = "raw"
+  min_ram_mb       = 1536
+  min_disk_gb      = 10
+
+  tags = ["tf-example"]
+}
@@ -0,0 +1,5 @@
+resource "vkcs_images_image_member" "partner" {
+  image_id = vkcs_images_image.golden.id
+  # ID of the project to share the image with.
+  member_id = "b5d4e6d9a6f94b43a6a3b6bd2fa7c7e1"
+}
@@ -0,0 +1,9 @@
+resource "vkcs_images_image" "golden" {
+  name             = "golden-tf-example"
+  image_source_url = "https://cloud-images.ubuntu.com/releases/jammy/release/ubuntu-22.04-server-cloudimg-amd64.img"
+  container_format = "bare"
+  disk_format      = "qcow2"
+  # Only images with shared visibility can have members.
+  visibility = "shared"
+  tags       = ["tf-example"]
+}
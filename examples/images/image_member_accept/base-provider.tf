@@ -0,0 +1,5 @@
+# Provider configured for the project the image is shared with
+provider "vkcs" {
+  alias      = "target"
+  project_id = "b5d4e6d9a6f94b43a6a3b6bd2fa7c7e1"
+}
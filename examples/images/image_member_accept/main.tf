@@ -0,0 +1,14 @@
+resource "vkcs_images_image_member_accept" "golden" {
+  provider = vkcs.target
+  image_id = vkcs_images_image_member.partner.image_id
+}
+
+# The accepted image is listed by the image data source in the target project.
+data "vkcs_images_image" "golden" {
+  provider      = vkcs.target
+  name          = "golden-tf-example"
+  visibility    = "shared"
+  member_status = "accepted"
+
+  depends_on = [vkcs_images_image_member_accept.golden]
+}
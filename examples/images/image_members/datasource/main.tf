@@ -0,0 +1,3 @@
+data "vkcs_images_image_members" "golden" {
+  image_id = vkcs_images_image_member.partner.image_id
+}
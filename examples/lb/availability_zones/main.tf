@@ -0,0 +1,6 @@
+data "vkcs_lb_availability_zones" "zones" {}
+
+output "lb_availability_zones" {
+  value       = [for z in data.vkcs_lb_availability_zones.zones.availability_zones : z.name if z.enabled]
+  description = "Names of enabled loadbalancer availability zones"
+}
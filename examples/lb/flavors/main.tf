@@ -0,0 +1,6 @@
+data "vkcs_lb_flavors" "flavors" {}
+
+output "lb_flavor_names" {
+  value       = data.vkcs_lb_flavors.flavors.flavors[*].name
+  description = "Names of available loadbalancer flavors"
+}
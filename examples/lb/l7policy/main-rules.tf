@@ -0,0 +1,20 @@
+resource "vkcs_lb_l7policy" "api_redirect" {
+  name             = "api-tf-example"
+  description      = "Policy with inline rules for tf lb testing"
+  action           = "REDIRECT_TO_POOL"
+  position         = 2
+  listener_id      = vkcs_lb_listener.app_http.id
+  redirect_pool_id = vkcs_lb_pool.http.id
+
+  rule {
+    type         = "HOST_NAME"
+    compare_type = "EQUAL_TO"
+    value        = "api.example.com"
+  }
+
+  rule {
+    type         = "PATH"
+    compare_type = "STARTS_WITH"
+    value        = "/v2"
+  }
+}
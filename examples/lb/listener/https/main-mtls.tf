@@ -0,0 +1,13 @@
+resource "vkcs_lb_listener" "partner_api" {
+  name                      = "partner-api-tf-example"
+  description               = "Listener with mutual TLS for partner APIs"
+  protocol                  = "TERMINATED_HTTPS"
+  protocol_port             = 9443
+  loadbalancer_id           = vkcs_lb_loadbalancer.app.id
+  default_tls_container_ref = vkcs_keymanager_container.lb_cert.container_ref
+  tls_versions              = ["TLSv1.2", "TLSv1.3"]
+  alpn_protocols            = ["h2", "http/1.1"]
+  # Require clients to present a certificate signed by the partner CA.
+  client_authentication       = "MANDATORY"
+  client_ca_tls_container_ref = vkcs_keymanager_secret.certificate.secret_ref
+}
@@ -0,0 +1,8 @@
+data "vkcs_lb_listener_stats" "app_http" {
+  listener_id = vkcs_lb_listener.app_http.id
+}
+
+output "app_http_active_connections" {
+  value       = data.vkcs_lb_listener_stats.app_http.active_connections
+  description = "Number of active connections of the app listener"
+}
@@ -0,0 +1,18 @@
+data "vkcs_lb_flavors" "flavors" {}
+
+data "vkcs_lb_availability_zones" "zones" {}
+
+locals {
+  lb_flavor = one([for f in data.vkcs_lb_flavors.flavors.flavors : f if f.name == "standard"])
+}
+
+resource "vkcs_lb_loadbalancer" "sized" {
+  name              = "sized-tf-example"
+  description       = "Loadbalancer with explicit flavor and availability zone"
+  vip_subnet_id     = vkcs_networking_subnet.app.id
+  flavor_id         = local.lb_flavor.id
+  availability_zone = data.vkcs_lb_availability_zones.zones.availability_zones[0].name
+  # Change this value to recreate amphorae of the loadbalancer,
+  # e.g. after maintenance of the underlying hosts.
+  failover_trigger = "2024-01-15"
+}
@@ -0,0 +1 @@
+../members/base-computes.tf
\ No newline at end of file
@@ -0,0 +1 @@
+../../firewall/main.tf
\ No newline at end of file
@@ -0,0 +1 @@
+../loadbalancer/main.tf
\ No newline at end of file
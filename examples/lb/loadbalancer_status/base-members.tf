@@ -0,0 +1 @@
+../members/main.tf
\ No newline at end of file
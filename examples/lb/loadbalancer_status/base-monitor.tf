@@ -0,0 +1 @@
+../monitor/main.tf
\ No newline at end of file
@@ -0,0 +1,19 @@
+data "vkcs_lb_loadbalancer_status" "app" {
+  loadbalancer_id = vkcs_lb_loadbalancer.app.id
+  # This is unnecessary in real life.
+  # This is required here to let the example work with members and monitor resource examples.
+  depends_on = [vkcs_lb_members.front_workers, vkcs_lb_monitor.worker_ping_life_checker]
+}
+
+check "lb_members_online" {
+  assert {
+    condition = alltrue(flatten([
+      for listener in data.vkcs_lb_loadbalancer_status.app.listeners : [
+        for pool in listener.pools : [
+          for member in pool.members : member.operating_status == "ONLINE"
+        ]
+      ]
+    ]))
+    error_message = "Not all loadbalancer members are ONLINE."
+  }
+}
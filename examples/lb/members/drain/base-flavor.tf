@@ -0,0 +1 @@
+../../../compute/flavor/main.tf
\ No newline at end of file
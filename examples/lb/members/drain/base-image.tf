@@ -0,0 +1 @@
+../../../images/image/datasource/main.tf
\ No newline at end of file
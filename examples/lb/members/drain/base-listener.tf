@@ -0,0 +1 @@
+../../listener/main.tf
\ No newline at end of file
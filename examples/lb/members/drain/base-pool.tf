@@ -0,0 +1 @@
+../../pool/main.tf
\ No newline at end of file
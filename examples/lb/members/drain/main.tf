@@ -0,0 +1,53 @@
+resource "vkcs_compute_instance" "front_worker" {
+  count     = 2
+  name      = "front-worker-${count.index}-tf-example"
+  flavor_id = data.vkcs_compute_flavor.basic.id
+
+  block_device {
+    source_type           = "image"
+    uuid                  = data.vkcs_images_image.debian.id
+    destination_type      = "volume"
+    volume_size           = 10
+    delete_on_termination = true
+  }
+
+  security_group_ids = [
+    vkcs_networking_secgroup.admin.id,
+    vkcs_networking_secgroup.http.id
+  ]
+
+  network {
+    uuid = vkcs_networking_network.app.id
+  }
+
+  depends_on = [
+    vkcs_networking_router_interface.app
+  ]
+
+  # Add the replacement instance to the pool before the old one is drained
+  # and removed.
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+
+resource "vkcs_lb_members" "front_workers" {
+  pool_id = vkcs_lb_pool.http.id
+  # Stop sending new connections to removed members and give existing ones
+  # up to 2 minutes to finish before members are deleted.
+  drain         = true
+  drain_timeout = 120
+
+  dynamic "member" {
+    for_each = vkcs_compute_instance.front_worker
+    content {
+      address       = member.value.access_ip_v4
+      protocol_port = 8080
+    }
+  }
+
+  timeouts {
+    update = "15m"
+    delete = "15m"
+  }
+}
@@ -0,0 +1,28 @@
+resource "vkcs_keymanager_container" "backend_client_cert" {
+  name = "backend-client-cert-tf-example"
+  type = "certificate"
+
+  secret_refs {
+    name       = "certificate"
+    secret_ref = vkcs_keymanager_secret.certificate.secret_ref
+  }
+
+  secret_refs {
+    name       = "private_key"
+    secret_ref = vkcs_keymanager_secret.priv_key.secret_ref
+  }
+}
+
+resource "vkcs_lb_pool" "https_reencrypt" {
+  name            = "https-reencrypt-tf-example"
+  description     = "Pool with TLS connections to members"
+  loadbalancer_id = vkcs_lb_loadbalancer.app.id
+  protocol        = "HTTP"
+  lb_method       = "ROUND_ROBIN"
+  tls_enabled     = true
+  # Client certificate presented to members.
+  tls_container_ref = vkcs_keymanager_container.backend_client_cert.container_ref
+  # CA bundle to verify certificates of members.
+  ca_tls_container_ref = vkcs_keymanager_secret.certificate.secret_ref
+  tls_versions         = ["TLSv1.2", "TLSv1.3"]
+}
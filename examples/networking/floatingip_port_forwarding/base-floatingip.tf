@@ -0,0 +1,5 @@
+resource "vkcs_networking_floatingip" "shared" {
+  pool        = "internet"
+  description = "floating ip shared by several hosts tf example"
+  sdn         = "neutron"
+}
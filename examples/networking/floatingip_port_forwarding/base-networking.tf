@@ -0,0 +1,40 @@
+resource "vkcs_networking_network" "app" {
+  name = "app-tf-example"
+  sdn  = "neutron"
+}
+
+resource "vkcs_networking_subnet" "app" {
+  name       = "app-tf-example"
+  network_id = vkcs_networking_network.app.id
+  cidr       = "192.168.199.0/24"
+  sdn        = "neutron"
+}
+
+# Get external network with Internet access
+data "vkcs_networking_network" "extnet" {
+  name = "internet"
+  sdn  = "neutron"
+}
+
+resource "vkcs_networking_router" "router" {
+  name                = "router-tf-example"
+  external_network_id = data.vkcs_networking_network.extnet.id
+  sdn                 = "neutron"
+}
+
+resource "vkcs_networking_router_interface" "app" {
+  router_id = vkcs_networking_router.router.id
+  subnet_id = vkcs_networking_subnet.app.id
+  sdn       = "neutron"
+}
+
+resource "vkcs_networking_port" "web" {
+  name       = "web-tf-example"
+  network_id = vkcs_networking_network.app.id
+  sdn        = "neutron"
+  fixed_ip {
+    subnet_id = vkcs_networking_subnet.app.id
+  }
+
+  depends_on = [vkcs_networking_router_interface.app]
+}
@@ -0,0 +1,21 @@
+resource "vkcs_networking_floatingip_port_forwarding" "ssh" {
+  floatingip_id       = vkcs_networking_floatingip.shared.id
+  protocol            = "tcp"
+  external_port       = 2222
+  internal_port       = 22
+  internal_ip_address = vkcs_networking_port.web.all_fixed_ips[0]
+  internal_port_id    = vkcs_networking_port.web.id
+  description         = "SSH access to the web host"
+  sdn                 = "neutron"
+}
+
+resource "vkcs_networking_floatingip_port_forwarding" "app" {
+  floatingip_id = vkcs_networking_floatingip.shared.id
+  protocol      = "tcp"
+  # Ranges must have the same size
+  external_port_range = "8000:8009"
+  internal_port_range = "9000:9009"
+  internal_ip_address = vkcs_networking_port.web.all_fixed_ips[0]
+  internal_port_id    = vkcs_networking_port.web.id
+  sdn                 = "neutron"
+}
@@ -0,0 +1,15 @@
+resource "vkcs_networking_port" "keepalived_master" {
+  name       = "keepalived-master-tf-example"
+  network_id = vkcs_networking_network.app.id
+  # Hostname is resolved within subnets with enabled private DNS.
+  dns_name = "keepalived-master"
+  fixed_ip {
+    subnet_id = vkcs_networking_subnet.app.id
+  }
+  # Allow the virtual IP to be active on the port.
+  allowed_address_pairs {
+    ip_address = "192.168.199.250"
+  }
+
+  depends_on = [vkcs_networking_router_interface.app]
+}
@@ -0,0 +1,6 @@
+resource "vkcs_networking_qos_bandwidth_limit_rule" "egress" {
+  qos_policy_id  = vkcs_networking_qos_policy.replication.id
+  max_kbps       = 100000
+  max_burst_kbps = 10000
+  direction      = "egress"
+}
@@ -0,0 +1,5 @@
+resource "vkcs_networking_qos_policy" "replication" {
+  name        = "replication-tf-example"
+  description = "Bandwidth policy for database replication ports"
+  tags        = ["tf-example"]
+}
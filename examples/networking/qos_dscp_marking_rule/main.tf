@@ -0,0 +1,4 @@
+resource "vkcs_networking_qos_dscp_marking_rule" "af31" {
+  qos_policy_id = vkcs_networking_qos_policy.replication.id
+  dscp_mark     = 26
+}
@@ -0,0 +1,11 @@
+resource "vkcs_networking_qos_policy" "replication" {
+  name        = "replication-tf-example"
+  description = "Bandwidth policy for database replication ports"
+  tags        = ["tf-example"]
+}
+
+# The policy applies to all ports of the network without their own QoS policy.
+resource "vkcs_networking_network" "replication" {
+  name          = "replication-tf-example"
+  qos_policy_id = vkcs_networking_qos_policy.replication.id
+}
@@ -0,0 +1,11 @@
+resource "vkcs_networking_network" "shared_services" {
+  name = "shared-services-tf-example"
+  sdn  = "neutron"
+}
+
+resource "vkcs_networking_subnet" "shared_services" {
+  name       = "shared-services-tf-example"
+  network_id = vkcs_networking_network.shared_services.id
+  cidr       = "192.168.199.0/24"
+  sdn        = "neutron"
+}
@@ -0,0 +1,9 @@
+resource "vkcs_networking_rbac_policy" "shared_services" {
+  object_type = "network"
+  object_id   = vkcs_networking_network.shared_services.id
+  action      = "access_as_shared"
+  # ID of the project to share the network with.
+  target_project_id = "b5d4e6d9a6f94b43a6a3b6bd2fa7c7e1"
+  # Must match the SDN of the network.
+  sdn = "neutron"
+}
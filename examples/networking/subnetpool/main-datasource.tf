@@ -0,0 +1,7 @@
+data "vkcs_networking_subnetpool" "company" {
+  name = "company-tf-example"
+  sdn  = "neutron"
+  # This is unnecessary in real life.
+  # This is required here to let the example work with subnet pool resource example.
+  depends_on = [vkcs_networking_subnetpool.company]
+}
@@ -0,0 +1,32 @@
+resource "vkcs_networking_subnetpool" "company" {
+  name        = "company-tf-example"
+  description = "Company address space carved into project subnets"
+  prefixes    = ["10.64.0.0/16"]
+  # Allocate /24 subnets unless another prefix length is requested
+  default_prefix_length = 24
+  min_prefix_length     = 20
+  max_prefix_length     = 28
+  sdn                   = "neutron"
+}
+
+resource "vkcs_networking_network" "app" {
+  name = "app-tf-example"
+  sdn  = "neutron"
+}
+
+# CIDR of the subnet is allocated from the subnet pool
+# and is available in the cidr attribute.
+resource "vkcs_networking_subnet" "app" {
+  name          = "app-tf-example"
+  network_id    = vkcs_networking_network.app.id
+  subnetpool_id = vkcs_networking_subnetpool.company.id
+  sdn           = "neutron"
+}
+
+resource "vkcs_networking_subnet" "db" {
+  name          = "db-tf-example"
+  network_id    = vkcs_networking_network.app.id
+  subnetpool_id = vkcs_networking_subnetpool.company.id
+  prefix_length = 27
+  sdn           = "neutron"
+}
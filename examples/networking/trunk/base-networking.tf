@@ -0,0 +1,43 @@
+resource "vkcs_networking_network" "app" {
+  name = "app-tf-example"
+  sdn  = "neutron"
+}
+
+resource "vkcs_networking_subnet" "app" {
+  name       = "app-tf-example"
+  network_id = vkcs_networking_network.app.id
+  cidr       = "192.168.199.0/24"
+  sdn        = "neutron"
+}
+
+resource "vkcs_networking_network" "db" {
+  name = "db-tf-example"
+  sdn  = "neutron"
+}
+
+resource "vkcs_networking_subnet" "db" {
+  name       = "db-tf-example"
+  network_id = vkcs_networking_network.db.id
+  cidr       = "192.168.166.0/24"
+  sdn        = "neutron"
+}
+
+resource "vkcs_networking_port" "trunk_parent" {
+  name       = "trunk-parent-tf-example"
+  network_id = vkcs_networking_network.app.id
+  sdn        = "neutron"
+
+  fixed_ip {
+    subnet_id = vkcs_networking_subnet.app.id
+  }
+}
+
+resource "vkcs_networking_port" "db_vlan" {
+  name       = "db-vlan-tf-example"
+  network_id = vkcs_networking_network.db.id
+  sdn        = "neutron"
+
+  fixed_ip {
+    subnet_id = vkcs_networking_subnet.db.id
+  }
+}
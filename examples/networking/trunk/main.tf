@@ -0,0 +1,27 @@
+resource "vkcs_networking_trunk" "app" {
+  name    = "app-tf-example"
+  port_id = vkcs_networking_port.trunk_parent.id
+  # Traffic of 'db' network is passed to the instance with VLAN tag 100.
+  sub_port {
+    port_id           = vkcs_networking_port.db_vlan.id
+    segmentation_type = "vlan"
+    segmentation_id   = 100
+  }
+}
+
+resource "vkcs_compute_instance" "app" {
+  name              = "app-tf-example"
+  availability_zone = "GZ1"
+  flavor_name       = "Basic-1-2-20"
+  block_device {
+    source_type           = "image"
+    uuid                  = data.vkcs_images_image.debian.id
+    destination_type      = "volume"
+    volume_size           = 10
+    delete_on_termination = true
+  }
+  # Attach the instance to the trunk through its parent port.
+  network {
+    port = vkcs_networking_trunk.app.port_id
+  }
+}
@@ -0,0 +1,10 @@
+data "vkcs_db_instance_log" "slow_query" {
+  instance_id = "e7da2869-2ae2-4900-99e3-a44fec2b11ac"
+  name        = "slow_query"
+  lines       = 100
+}
+
+output "slow_queries" {
+  value       = join("\n", data.vkcs_db_instance_log.slow_query.content)
+  description = "Latest 100 lines of slow query log of the instance."
+}
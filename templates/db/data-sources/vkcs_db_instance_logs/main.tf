@@ -0,0 +1,8 @@
+data "vkcs_db_instance_logs" "logs" {
+  instance_id = "e7da2869-2ae2-4900-99e3-a44fec2b11ac"
+}
+
+output "published_logs" {
+  value       = [for l in data.vkcs_db_instance_logs.logs.logs : l.name if l.status == "Published"]
+  description = "Names of published logs of the instance."
+}
@@ -0,0 +1 @@
+terraform import vkcs_db_replica.myreplica 708a74a1-6b00-4a96-938c-28a8a6d98590
@@ -0,0 +1 @@
+terraform import vkcs_images_image_member_accept.golden 89c60255-9bd6-460c-822a-e2b959ede9d2/b5d4e6d9a6f94b43a6a3b6bd2fa7c7e1
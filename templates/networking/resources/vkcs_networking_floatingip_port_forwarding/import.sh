@@ -0,0 +1 @@
+terraform import vkcs_networking_floatingip_port_forwarding.ssh 2c7f39f3-702b-48d1-940c-b50384177ee1/46dfb556-b92f-48ce-94c5-9a9e2140de94
@@ -0,0 +1 @@
+terraform import vkcs_networking_qos_policy.replication d6ae28ce-fcb5-4180-aa62-d260a27e09ae
@@ -0,0 +1 @@
+terraform import vkcs_networking_rbac_policy.shared_services 5e3a9f8a-1d5c-4b4e-9c9e-0a9a7c2f6d41
@@ -0,0 +1 @@
+terraform import vkcs_networking_subnetpool.company 0b4d8a4b-3d79-4b9e-8e4c-2f1a4b51c7e3
@@ -0,0 +1 @@
+terraform import vkcs_networking_trunk.app 7a5c1e2b-3f4d-4e8a-9b6c-2d1f0e9a8b7c
@@ -77,6 +77,37 @@ func DataSourceBlockStorageVolume() *schema.Resource {
 				Computed:    true,
 				Description: "The name of the availability zone of the volume.",
 			},
+
+			"multiattach": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates if the volume can be attached to several instances at the same time.",
+			},
+
+			"attachment": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the attachment.",
+						},
+						"instance_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the instance the volume is attached to.",
+						},
+						"device": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The device the volume is attached as.",
+						},
+					},
+				},
+				Description: "The list of attachments of the volume.",
+			},
 		},
 		Description: "Use this data source to get information about an existing volume.",
 	}
@@ -127,6 +158,8 @@ func dataSourceBlockStorageVolumeAttributes(d *schema.ResourceData, volume volum
 	d.Set("size", volume.Size)
 	d.Set("source_volume_id", volume.SourceVolID)
 	d.Set("availability_zone", volume.AvailabilityZone)
+	d.Set("multiattach", volume.Multiattach)
+	d.Set("attachment", flattenBlockStorageVolumeAttachments(volume.Attachments))
 
 	if err := d.Set("metadata", volume.Metadata); err != nil {
 		log.Printf("[DEBUG] Unable to set metadata for vkcs_blockstorage_volume %s: %s", volume.ID, err)
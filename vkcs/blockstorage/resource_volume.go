@@ -28,6 +28,7 @@ var (
 	BSVolumeStatusActive      = "available"
 	BSVolumeStatusInUse       = "in-use"
 	BSVolumeStatusDetaching   = "detaching"
+	bsVolumeStatusDetached    = "detached"
 	bsVolumeStatusRetype      = "retyping"
 	bsVolumeStatusExtending   = "extending"
 	bsVolumeStatusAttaching   = "attaching"
@@ -128,6 +129,14 @@ func ResourceBlockStorageVolume() *schema.Resource {
 				Description:   "ID of the image to create volume with. Changing this creates a new volume. Only one of snapshot_id, source_volume_id, image_id fields may be set.",
 			},
 
+			"multiattach": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Whether the volume can be attached to several instances at the same time. The `volume_type` must support multiattach. Changing this creates a new volume.",
+			},
+
 			"all_metadata": {
 				Type: schema.TypeMap,
 				Elem: &schema.Schema{
@@ -136,6 +145,31 @@ func ResourceBlockStorageVolume() *schema.Resource {
 				Computed:    true,
 				Description: "Map of key-value metadata of the volume.",
 			},
+
+			"attachment": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the attachment.",
+						},
+						"instance_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the instance the volume is attached to.",
+						},
+						"device": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The device the volume is attached as.",
+						},
+					},
+				},
+				Description: "The list of attachments of the volume. A multiattach volume may have several attachments.",
+			},
 		},
 		Description: "Provides a blockstorage volume resource. This can be used to create, modify and delete blockstorage volume.",
 	}
@@ -159,6 +193,7 @@ func resourceBlockStorageVolumeCreate(ctx context.Context, d *schema.ResourceDat
 		SourceVolID:      d.Get("source_vol_id").(string),
 		ImageID:          d.Get("image_id").(string),
 		Metadata:         util.ExpandToMapStringString(metadata),
+		Multiattach:      d.Get("multiattach").(bool),
 	}
 
 	log.Printf("[DEBUG] vkcs_blockstorage_volume create options: %#v", createOpts)
@@ -211,6 +246,8 @@ func resourceBlockStorageVolumeRead(ctx context.Context, d *schema.ResourceData,
 	d.Set("source_vol_id", v.SourceVolID)
 	d.Set("region", util.GetRegion(d, config))
 	d.Set("all_metadata", v.Metadata)
+	d.Set("multiattach", v.Multiattach)
+	d.Set("attachment", flattenBlockStorageVolumeAttachments(v.Attachments))
 
 	configMetadata := d.Get("metadata").(map[string]any)
 	intersectionMetadata := make(map[string]string, len(configMetadata))
@@ -309,6 +346,22 @@ func resourceBlockStorageVolumeDelete(ctx context.Context, d *schema.ResourceDat
 		return diag.Errorf("Error creating VKCS block storage client: %s", err)
 	}
 
+	// Attachments of the volume may still be detaching when the volume is
+	// deleted right after vkcs_compute_volume_attach resources.
+	detachStateConf := &retry.StateChangeConf{
+		Pending:    []string{BSVolumeStatusDetaching},
+		Target:     []string{bsVolumeStatusDetached},
+		Refresh:    blockStorageVolumeDetachRefreshFunc(blockStorageClient, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      0,
+		MinTimeout: bsVolumeMinTimeout,
+	}
+
+	_, err = detachStateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("error waiting for vkcs_blockstorage_volume %s to detach: %s", d.Id(), err)
+	}
+
 	err = ivolumes.Delete(blockStorageClient, d.Id(), nil).ExtractErr()
 	if err != nil {
 		return diag.FromErr(util.CheckDeleted(d, err, "Error deleting vkcs_blockstorage_volume"))
@@ -0,0 +1,182 @@
+package blockstorage
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/volumetransfers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/clients"
+	ivolumes "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/blockstorage/v3/volumes"
+	ivolumetransfers "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/blockstorage/v3/volumetransfers"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/util"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/util/errutil"
+)
+
+const (
+	bsVolumeTransferTimeout = 10 * time.Minute
+)
+
+var (
+	bsVolumeStatusAwaitingTransfer = "awaiting-transfer"
+)
+
+func ResourceBlockStorageVolumeTransfer() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBlockStorageVolumeTransferCreate,
+		ReadContext:   resourceBlockStorageVolumeTransferRead,
+		DeleteContext: resourceBlockStorageVolumeTransferDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(bsVolumeTransferTimeout),
+			Delete: schema.DefaultTimeout(bsVolumeTransferTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The region in which to obtain the Block Storage client. If omitted, the `region` argument of the provider is used. Changing this creates a new transfer.",
+			},
+
+			"volume_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the volume to transfer. The volume must be in `available` status. Changing this creates a new transfer.",
+			},
+
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The name of the transfer. Changing this creates a new transfer.",
+			},
+
+			"auth_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The authentication key that must be passed to vkcs_blockstorage_volume_transfer_accept to accept the transfer.",
+			},
+
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time when the transfer was created.",
+			},
+		},
+		Description: "Creates a transfer of a blockstorage volume to another project. The transfer is accepted in the target project with vkcs_blockstorage_volume_transfer_accept.",
+	}
+}
+
+func resourceBlockStorageVolumeTransferCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(clients.Config)
+	blockStorageClient, err := config.BlockStorageV3Client(util.GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating VKCS block storage client: %s", err)
+	}
+
+	createOpts := volumetransfers.CreateOpts{
+		VolumeID: d.Get("volume_id").(string),
+		Name:     d.Get("name").(string),
+	}
+
+	log.Printf("[DEBUG] vkcs_blockstorage_volume_transfer create options: %#v", createOpts)
+
+	transfer, err := ivolumetransfers.Create(blockStorageClient, createOpts).Extract()
+	if err != nil {
+		return diag.Errorf("error creating vkcs_blockstorage_volume_transfer: %s", err)
+	}
+
+	d.SetId(transfer.ID)
+	// auth_key is returned only in the response to the create request.
+	d.Set("auth_key", transfer.AuthKey)
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{BSVolumeStatusActive},
+		Target:     []string{bsVolumeStatusAwaitingTransfer},
+		Refresh:    BlockStorageVolumeStateRefreshFunc(blockStorageClient, transfer.VolumeID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      0,
+		MinTimeout: bsVolumeMinTimeout,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("error waiting for vkcs_blockstorage_volume_transfer %s to become ready: %s", transfer.ID, err)
+	}
+
+	return resourceBlockStorageVolumeTransferRead(ctx, d, meta)
+}
+
+func resourceBlockStorageVolumeTransferRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(clients.Config)
+	blockStorageClient, err := config.BlockStorageV3Client(util.GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating VKCS block storage client: %s", err)
+	}
+
+	transfer, err := ivolumetransfers.Get(blockStorageClient, d.Id()).Extract()
+	if err != nil {
+		if !errutil.IsNotFound(err) {
+			return diag.FromErr(util.CheckDeleted(d, err, "error retrieving vkcs_blockstorage_volume_transfer"))
+		}
+
+		// The transfer disappears both when it is accepted and when it is
+		// cancelled. An accepted volume leaves the source project, so the
+		// transfer is kept in the state to not be created again.
+		volumeID := d.Get("volume_id").(string)
+		_, volumeErr := ivolumes.Get(blockStorageClient, volumeID).Extract()
+		if errutil.IsNotFound(volumeErr) {
+			log.Printf("[DEBUG] vkcs_blockstorage_volume_transfer %s has been accepted", d.Id())
+			return nil
+		}
+
+		return diag.FromErr(util.CheckDeleted(d, err, "error retrieving vkcs_blockstorage_volume_transfer"))
+	}
+
+	log.Printf("[DEBUG] Retrieved vkcs_blockstorage_volume_transfer %s: %#v", d.Id(), transfer)
+
+	d.Set("volume_id", transfer.VolumeID)
+	d.Set("name", transfer.Name)
+	d.Set("created_at", transfer.CreatedAt.Format(time.RFC3339))
+	d.Set("region", util.GetRegion(d, config))
+
+	return nil
+}
+
+func resourceBlockStorageVolumeTransferDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(clients.Config)
+	blockStorageClient, err := config.BlockStorageV3Client(util.GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating VKCS block storage client: %s", err)
+	}
+
+	err = ivolumetransfers.Delete(blockStorageClient, d.Id()).ExtractErr()
+	if err != nil {
+		return diag.FromErr(util.CheckDeleted(d, err, "Error deleting vkcs_blockstorage_volume_transfer"))
+	}
+
+	volumeID := d.Get("volume_id").(string)
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{bsVolumeStatusAwaitingTransfer},
+		Target:     []string{BSVolumeStatusActive, BSVolumeStatusDeleted},
+		Refresh:    BlockStorageVolumeStateRefreshFunc(blockStorageClient, volumeID),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      0,
+		MinTimeout: bsVolumeMinTimeout,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("error waiting for vkcs_blockstorage_volume_transfer %s to delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package blockstorage
+
+import (
+	"context"
+	"log"
+
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/volumetransfers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/clients"
+	ivolumes "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/blockstorage/v3/volumes"
+	ivolumetransfers "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/blockstorage/v3/volumetransfers"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/util"
+)
+
+func ResourceBlockStorageVolumeTransferAccept() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBlockStorageVolumeTransferAcceptCreate,
+		ReadContext:   resourceBlockStorageVolumeTransferAcceptRead,
+		DeleteContext: resourceBlockStorageVolumeTransferAcceptDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(bsVolumeTransferTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The region in which to obtain the Block Storage client. If omitted, the `region` argument of the provider is used. Changing this creates a new resource.",
+			},
+
+			"transfer_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the volume transfer to accept. Changing this creates a new resource.",
+			},
+
+			"auth_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: "The authentication key of the volume transfer. Changing this creates a new resource.",
+			},
+
+			"volume_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the accepted volume. The volume can be imported into vkcs_blockstorage_volume in the target project.",
+			},
+		},
+		Description: "Accepts a blockstorage volume transfer in the project of the provider. Usually the provider of this resource is configured for another project than the one of vkcs_blockstorage_volume_transfer. " +
+			"_note_ Acceptance of a transfer cannot be reverted, so deleting this resource only removes it from the state.",
+	}
+}
+
+func resourceBlockStorageVolumeTransferAcceptCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(clients.Config)
+	blockStorageClient, err := config.BlockStorageV3Client(util.GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating VKCS block storage client: %s", err)
+	}
+
+	transferID := d.Get("transfer_id").(string)
+	acceptOpts := volumetransfers.AcceptOpts{
+		AuthKey: d.Get("auth_key").(string),
+	}
+
+	log.Printf("[DEBUG] Accepting vkcs_blockstorage_volume_transfer %s", transferID)
+
+	transfer, err := ivolumetransfers.Accept(blockStorageClient, transferID, acceptOpts).Extract()
+	if err != nil {
+		return diag.Errorf("error accepting vkcs_blockstorage_volume_transfer %s: %s", transferID, err)
+	}
+
+	d.SetId(transfer.ID)
+	d.Set("volume_id", transfer.VolumeID)
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{bsVolumeStatusAwaitingTransfer, BSVolumeStatusDeleted},
+		Target:     []string{BSVolumeStatusActive},
+		Refresh:    BlockStorageVolumeStateRefreshFunc(blockStorageClient, transfer.VolumeID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      0,
+		MinTimeout: bsVolumeMinTimeout,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("error waiting for volume %s of vkcs_blockstorage_volume_transfer %s to become available: %s", transfer.VolumeID, transfer.ID, err)
+	}
+
+	return resourceBlockStorageVolumeTransferAcceptRead(ctx, d, meta)
+}
+
+func resourceBlockStorageVolumeTransferAcceptRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(clients.Config)
+	blockStorageClient, err := config.BlockStorageV3Client(util.GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating VKCS block storage client: %s", err)
+	}
+
+	volumeID := d.Get("volume_id").(string)
+	v, err := ivolumes.Get(blockStorageClient, volumeID).Extract()
+	if err != nil {
+		return diag.FromErr(util.CheckDeleted(d, err, "error retrieving volume of vkcs_blockstorage_volume_transfer_accept"))
+	}
+
+	log.Printf("[DEBUG] Retrieved volume of vkcs_blockstorage_volume_transfer_accept %s: %#v", d.Id(), v)
+
+	d.Set("region", util.GetRegion(d, config))
+
+	return nil
+}
+
+func resourceBlockStorageVolumeTransferAcceptDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] vkcs_blockstorage_volume_transfer_accept %s cannot be reverted, removing it from the state only", d.Id())
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package blockstorage_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/acctest"
+)
+
+func TestAccBlockStorageVolumeTransferAccept_basic(t *testing.T) {
+	if acctest.OsTargetProjectID == "" {
+		t.Skip("OS_TARGET_PROJECT_ID is not set, skipping volume transfer accept test.")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.AccTestPreCheck(t) },
+		ProviderFactories: acctest.AccTestProviders,
+		CheckDestroy:      testAccCheckBlockStorageVolumeTransferDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.AccTestRenderConfig(testAccBlockStorageVolumeTransferAcceptBasic),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("vkcs_blockstorage_volume_transfer_accept.accept_1", "volume_id",
+						"vkcs_blockstorage_volume.volume_1", "id"),
+				),
+				// The volume leaves the source project after acceptance, so
+				// vkcs_blockstorage_volume of the source project plans to
+				// create it again.
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config:             acctest.AccTestRenderConfig(testAccBlockStorageVolumeTransferAcceptImport, map[string]string{"TestAccBlockStorageVolumeTransferAcceptBasic": acctest.AccTestRenderConfig(testAccBlockStorageVolumeTransferAcceptBasic)}),
+				ResourceName:       "vkcs_blockstorage_volume.accepted",
+				ImportState:        true,
+				ImportStateIdFunc:  testAccBlockStorageVolumeTransferAcceptedVolumeID("vkcs_blockstorage_volume_transfer_accept.accept_1"),
+				ImportStateCheck:   testAccCheckBlockStorageVolumeTransferAcceptedVolume,
+				ImportStatePersist: true,
+			},
+		},
+	})
+}
+
+func testAccBlockStorageVolumeTransferAcceptedVolumeID(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		volumeID := rs.Primary.Attributes["volume_id"]
+		if volumeID == "" {
+			return "", fmt.Errorf("No volume_id is set")
+		}
+
+		return volumeID, nil
+	}
+}
+
+func testAccCheckBlockStorageVolumeTransferAcceptedVolume(states []*terraform.InstanceState) error {
+	if len(states) != 1 {
+		return fmt.Errorf("expected 1 imported volume, got %d", len(states))
+	}
+
+	if name := states[0].Attributes["name"]; name != "volume_1" {
+		return fmt.Errorf("expected imported volume name %q, got %q", "volume_1", name)
+	}
+
+	if size := states[0].Attributes["size"]; size != "1" {
+		return fmt.Errorf("expected imported volume size %q, got %q", "1", size)
+	}
+
+	return nil
+}
+
+const testAccBlockStorageVolumeTransferAcceptBasic = `
+provider "vkcs" {
+  alias      = "target"
+  project_id = "{{.TargetProjectID}}"
+}
+
+resource "vkcs_blockstorage_volume" "volume_1" {
+  name = "volume_1"
+  size = 1
+  availability_zone = "{{.AvailabilityZone}}"
+  volume_type = "{{.VolumeType}}"
+}
+
+resource "vkcs_blockstorage_volume_transfer" "transfer_1" {
+  name = "transfer_1"
+  volume_id = vkcs_blockstorage_volume.volume_1.id
+}
+
+resource "vkcs_blockstorage_volume_transfer_accept" "accept_1" {
+  provider = vkcs.target
+  transfer_id = vkcs_blockstorage_volume_transfer.transfer_1.id
+  auth_key = vkcs_blockstorage_volume_transfer.transfer_1.auth_key
+}
+`
+
+const testAccBlockStorageVolumeTransferAcceptImport = `
+{{.TestAccBlockStorageVolumeTransferAcceptBasic}}
+
+resource "vkcs_blockstorage_volume" "accepted" {
+  provider = vkcs.target
+  name = "volume_1"
+  size = 1
+  availability_zone = "{{.AvailabilityZone}}"
+  volume_type = "{{.VolumeType}}"
+}
+`
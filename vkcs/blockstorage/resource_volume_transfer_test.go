@@ -0,0 +1,97 @@
+package blockstorage_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/acctest"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/clients"
+	ivolumetransfers "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/blockstorage/v3/volumetransfers"
+)
+
+func TestAccBlockStorageVolumeTransfer_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.AccTestPreCheck(t) },
+		ProviderFactories: acctest.AccTestProviders,
+		CheckDestroy:      testAccCheckBlockStorageVolumeTransferDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.AccTestRenderConfig(testAccBlockStorageVolumeTransferBasic),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageVolumeTransferExists("vkcs_blockstorage_volume_transfer.transfer_1"),
+					resource.TestCheckResourceAttr("vkcs_blockstorage_volume_transfer.transfer_1", "name", "transfer_1"),
+					resource.TestCheckResourceAttrSet("vkcs_blockstorage_volume_transfer.transfer_1", "auth_key"),
+					resource.TestCheckResourceAttrPair("vkcs_blockstorage_volume_transfer.transfer_1", "volume_id",
+						"vkcs_blockstorage_volume.volume_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBlockStorageVolumeTransferDestroy(s *terraform.State) error {
+	config := acctest.AccTestProvider.Meta().(clients.Config)
+	blockStorageClient, err := config.BlockStorageV3Client(acctest.OsRegionName)
+	if err != nil {
+		return fmt.Errorf("Error creating VKCS block storage client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vkcs_blockstorage_volume_transfer" {
+			continue
+		}
+
+		_, err := ivolumetransfers.Get(blockStorageClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Volume transfer still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckBlockStorageVolumeTransferExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := acctest.AccTestProvider.Meta().(clients.Config)
+		blockStorageClient, err := config.BlockStorageV3Client(acctest.OsRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating VKCS block storage client: %s", err)
+		}
+
+		found, err := ivolumetransfers.Get(blockStorageClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Volume transfer not found")
+		}
+
+		return nil
+	}
+}
+
+const testAccBlockStorageVolumeTransferBasic = `
+resource "vkcs_blockstorage_volume" "volume_1" {
+  name = "volume_1"
+  size = 1
+  availability_zone = "{{.AvailabilityZone}}"
+  volume_type = "{{.VolumeType}}"
+}
+
+resource "vkcs_blockstorage_volume_transfer" "transfer_1" {
+  name = "transfer_1"
+  volume_id = vkcs_blockstorage_volume.volume_1.id
+}
+`
@@ -5,6 +5,7 @@ import (
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/volumeactions"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/volumes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	ivolumes "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/blockstorage/v3/volumes"
 	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/util"
@@ -36,3 +37,36 @@ func BlockStorageVolumeStateRefreshFunc(client *gophercloud.ServiceClient, volum
 		return v, v.Status, nil
 	}
 }
+
+// blockStorageVolumeDetachRefreshFunc reports the volume as pending only while
+// it is detaching. Any other status, including a missing volume, is left to
+// the delete request to handle.
+func blockStorageVolumeDetachRefreshFunc(client *gophercloud.ServiceClient, volumeID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		v, err := ivolumes.Get(client, volumeID).Extract()
+		if err != nil {
+			if errutil.IsNotFound(err) {
+				return v, bsVolumeStatusDetached, nil
+			}
+			return nil, "", err
+		}
+		if v.Status == BSVolumeStatusDetaching {
+			return v, v.Status, nil
+		}
+
+		return v, bsVolumeStatusDetached, nil
+	}
+}
+
+func flattenBlockStorageVolumeAttachments(attachments []volumes.Attachment) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(attachments))
+	for _, a := range attachments {
+		result = append(result, map[string]interface{}{
+			"id":          a.AttachmentID,
+			"instance_id": a.ServerID,
+			"device":      a.Device,
+		})
+	}
+
+	return result
+}
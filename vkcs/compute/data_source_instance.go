@@ -188,7 +188,9 @@ func dataSourceComputeInstanceRead(ctx context.Context, d *schema.ResourceData,
 
 	log.Printf("[DEBUG] Setting networks: %+v", networks)
 
-	d.Set("network", networks)
+	if err := d.Set("network", networks); err != nil {
+		return diag.Errorf("Error setting VKCS server's networks: %s", err)
+	}
 	d.Set("access_ip_v4", hostv4)
 
 	d.Set("metadata", server.Metadata)
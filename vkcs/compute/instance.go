@@ -21,8 +21,10 @@ import (
 
 	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/clients"
 	iservers "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/compute/v2/servers"
-	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/networking"
+	inetworking "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/networking"
+	iports "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/networking/v2/ports"
 	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/util"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/networking"
 )
 
 // InstanceNIC is a structured representation of a Gophercloud servers.Server
@@ -148,7 +150,7 @@ func getAllInstanceNetworks(d *schema.ResourceData, meta interface{}) ([]Instanc
 func getInstanceNetworkInfo(d *schema.ResourceData, meta interface{}, queryType, queryTerm string) (map[string]interface{}, error) {
 	config := meta.(clients.Config)
 
-	networkClient, err := config.NetworkingV2Client(util.GetRegion(d, config), networking.SearchInAllSDNs)
+	networkClient, err := config.NetworkingV2Client(util.GetRegion(d, config), inetworking.SearchInAllSDNs)
 	if err == nil {
 		networkInfo, err := getInstanceNetworkInfoNeutron(networkClient, queryType, queryTerm)
 		if err != nil {
@@ -413,6 +415,137 @@ func flattenInstanceNetworks(d *schema.ResourceData, meta interface{}) ([]map[st
 	return networks, nil
 }
 
+// getInstancePorts returns ports of the instance keyed by their MAC addresses.
+func getInstancePorts(d *schema.ResourceData, meta interface{}) (map[string]ports.Port, error) {
+	config := meta.(clients.Config)
+	networkClient, err := config.NetworkingV2Client(util.GetRegion(d, config), inetworking.SearchInAllSDNs)
+	if err != nil {
+		return nil, fmt.Errorf("error creating VKCS networking client: %s", err)
+	}
+
+	allPages, err := ports.List(networkClient, ports.ListOpts{DeviceID: d.Id()}).AllPages()
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve ports of the instance from the Network API: %s", err)
+	}
+
+	allPorts, err := ports.ExtractPorts(allPages)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve ports of the instance from the Network API: %s", err)
+	}
+
+	instancePorts := make(map[string]ports.Port, len(allPorts))
+	for _, p := range allPorts {
+		instancePorts[p.MACAddress] = p
+	}
+
+	return instancePorts, nil
+}
+
+// instanceNetworksHaveAllowedAddressPairs checks whether any of the instance
+// networks uses allowed address pairs.
+func instanceNetworksHaveAllowedAddressPairs(d *schema.ResourceData) bool {
+	for _, raw := range d.Get("network").([]interface{}) {
+		network, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if pairs, ok := network["allowed_address_pairs"].(*schema.Set); ok && pairs.Len() > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// flattenInstanceNetworksAllowedAddressPairs sets allowed address pairs of
+// the ports created for the instance networks. Allowed address pairs of
+// ports passed through network.port are managed by vkcs_networking_port.
+// Ports are only retrieved if any of the instance networks uses allowed
+// address pairs.
+func flattenInstanceNetworksAllowedAddressPairs(d *schema.ResourceData, meta interface{}, networks []map[string]interface{}) {
+	if !instanceNetworksHaveAllowedAddressPairs(d) {
+		return
+	}
+
+	instancePorts, err := getInstancePorts(d, meta)
+	if err != nil {
+		log.Printf("[WARN] Unable to get allowed address pairs of instance %s: %s", d.Id(), err)
+		return
+	}
+
+	for _, n := range networks {
+		if portID, ok := n["port"].(string); ok && portID != "" {
+			continue
+		}
+
+		mac, _ := n["mac"].(string)
+		if p, ok := instancePorts[mac]; ok {
+			n["allowed_address_pairs"] = networking.FlattenNetworkingPortAllowedAddressPairs(p.MACAddress, p.AllowedAddressPairs)
+		}
+	}
+}
+
+// updateInstanceNetworksAllowedAddressPairs updates allowed address pairs of
+// the ports created for the instance networks, if they have changed.
+func updateInstanceNetworksAllowedAddressPairs(d *schema.ResourceData, meta interface{}) error {
+	var changed bool
+	rawNetworks := d.Get("network").([]interface{})
+	for i := range rawNetworks {
+		if d.HasChange(fmt.Sprintf("network.%d.allowed_address_pairs", i)) {
+			changed = true
+		}
+	}
+
+	if !changed {
+		return nil
+	}
+
+	config := meta.(clients.Config)
+	networkClient, err := config.NetworkingV2Client(util.GetRegion(d, config), inetworking.SearchInAllSDNs)
+	if err != nil {
+		return fmt.Errorf("error creating VKCS networking client: %s", err)
+	}
+
+	networks, err := flattenInstanceNetworks(d, meta)
+	if err != nil {
+		return err
+	}
+
+	instancePorts, err := getInstancePorts(d, meta)
+	if err != nil {
+		return err
+	}
+
+	for i, raw := range rawNetworks {
+		key := fmt.Sprintf("network.%d.allowed_address_pairs", i)
+		if !d.HasChange(key) {
+			continue
+		}
+
+		if i >= len(networks) {
+			return fmt.Errorf("unable to find NIC of network.%d of the instance", i)
+		}
+
+		mac, _ := networks[i]["mac"].(string)
+		p, ok := instancePorts[mac]
+		if !ok {
+			return fmt.Errorf("unable to find port of network.%d of the instance with %s MAC address", i, mac)
+		}
+
+		pairs := networking.ExpandNetworkingPortAllowedAddressPairs(raw.(map[string]interface{})["allowed_address_pairs"].(*schema.Set))
+		updateOpts := ports.UpdateOpts{
+			AllowedAddressPairs: &pairs,
+		}
+
+		log.Printf("[DEBUG] Updating allowed address pairs of port %s of instance %s: %#v", p.ID, d.Id(), updateOpts)
+		_, err = iports.Update(networkClient, p.ID, updateOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("error updating allowed address pairs of port %s: %s", p.ID, err)
+		}
+	}
+
+	return nil
+}
+
 // getInstanceAccessAddresses determines the best IP address to communicate
 // with the instance. It does this by looping through all networks and looking
 // for a valid IP address. Priority is given to a network that was flagged as
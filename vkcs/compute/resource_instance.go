@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	imonitoring "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/monitoring/templater"
 	"github.com/vk-cs/terraform-provider-vkcs/vkcs/monitoring"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/networking"
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/availabilityzones"
@@ -231,6 +232,7 @@ func ResourceComputeInstance() *schema.Resource {
 							Default:     false,
 							Description: "Specifies if this network should be used for provisioning access. Accepts true or false. Defaults to false.",
 						},
+						"allowed_address_pairs": networking.NetworkingPortAllowedAddressPairsSchema("An IP/MAC Address pair of additional IP addresses that can be active on the port of this network, e.g. a virtual IP of a keepalived/VRRP cluster. The structure is the same as `allowed_address_pairs` of `vkcs_networking_port`. Conflicts with `port`, set `allowed_address_pairs` of `vkcs_networking_port` instead. Changing this updates allowed address pairs of the existing port."),
 					},
 				},
 				Description: "An array of one or more networks to attach to the instance. The network object structure is documented below. Changing this creates a new server.",
@@ -682,6 +684,10 @@ func resourceComputeInstanceCreate(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
+	if err := updateInstanceNetworksAllowedAddressPairs(d, meta); err != nil {
+		return diag.Errorf("Error setting allowed address pairs of vkcs_compute_instance %s: %s", d.Id(), err)
+	}
+
 	diags = append(diags, resourceComputeInstanceRead(ctx, d, meta)...)
 	return diags
 }
@@ -707,6 +713,7 @@ func resourceComputeInstanceRead(_ context.Context, d *schema.ResourceData, meta
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	flattenInstanceNetworksAllowedAddressPairs(d, meta, networks)
 
 	// Determine the best IPv4 addresses to access the instance with
 	hostv4 := getInstanceAccessAddresses(d, networks)
@@ -1069,6 +1076,12 @@ func resourceComputeInstanceUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
+	if d.HasChange("network") {
+		if err := updateInstanceNetworksAllowedAddressPairs(d, meta); err != nil {
+			return diag.Errorf("Error updating allowed address pairs of vkcs_compute_instance %s: %s", d.Id(), err)
+		}
+	}
+
 	// 	Perform any required updates to the tags.
 	if d.HasChange("tags") {
 		instanceTags := ComputeInstanceUpdateTags(d)
@@ -1242,6 +1255,43 @@ func resourceComputeInstanceCustomizeDiff(ctx context.Context, diff *schema.Reso
 		}
 	}
 
+	if err := validateComputeInstanceNetworksAllowedAddressPairs(diff.GetRawConfig().GetAttr("network")); err != nil {
+		return err
+	}
+
+	var networkObjects []networking.SDNObject
+	for i := range diff.Get("network").([]interface{}) {
+		networkObjects = append(networkObjects,
+			networking.SDNObject{Key: fmt.Sprintf("network.%d.uuid", i), Type: networking.SDNObjectNetwork},
+			networking.SDNObject{Key: fmt.Sprintf("network.%d.port", i), Type: networking.SDNObjectPort},
+		)
+	}
+
+	return networking.ValidateSDNConsistency(diff, v, networkObjects)
+}
+
+// validateComputeInstanceNetworksAllowedAddressPairs checks that allowed
+// address pairs are not set for networks attached through existing ports.
+func validateComputeInstanceNetworksAllowedAddressPairs(networks cty.Value) error {
+	if networks.IsNull() || !networks.IsKnown() {
+		return nil
+	}
+
+	i := 0
+	for it := networks.ElementIterator(); it.Next(); i++ {
+		_, network := it.Element()
+		if network.IsNull() || !network.IsKnown() {
+			continue
+		}
+
+		pairs := network.GetAttr("allowed_address_pairs")
+		if network.GetAttr("port").IsNull() || pairs.IsNull() || (pairs.IsKnown() && pairs.LengthInt() == 0) {
+			continue
+		}
+
+		return fmt.Errorf("network.%d: allowed_address_pairs cannot be set together with port, set allowed_address_pairs of vkcs_networking_port instead", i)
+	}
+
 	return nil
 }
 
@@ -388,6 +388,38 @@ func TestAccComputeInstance_changeFixedIP(t *testing.T) {
 	})
 }
 
+func TestAccComputeInstance_allowedAddressPairs(t *testing.T) {
+	var instance1 servers.Server
+	var instance2 servers.Server
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.AccTestPreCheck(t) },
+		ProviderFactories: acctest.AccTestProviders,
+		CheckDestroy:      testAccCheckComputeInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.AccTestRenderConfig(testAccComputeInstanceAllowedAddressPairs1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceExists(
+						"vkcs_compute_instance.instance_1", &instance1),
+					resource.TestCheckResourceAttr(
+						"vkcs_compute_instance.instance_1", "network.0.allowed_address_pairs.#", "1"),
+				),
+			},
+			{
+				Config: acctest.AccTestRenderConfig(testAccComputeInstanceAllowedAddressPairs2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceExists(
+						"vkcs_compute_instance.instance_1", &instance2),
+					testAccCheckComputeInstanceInstanceIDsMatch(&instance1, &instance2),
+					resource.TestCheckResourceAttr(
+						"vkcs_compute_instance.instance_1", "network.0.allowed_address_pairs.#", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccComputeInstance_stopBeforeDestroy(t *testing.T) {
 	var instance servers.Server
 	resource.Test(t, resource.TestCase{
@@ -1012,6 +1044,17 @@ func testAccCheckComputeInstanceInstanceIDsDoNotMatch(
 	}
 }
 
+func testAccCheckComputeInstanceInstanceIDsMatch(
+	instance1, instance2 *servers.Server) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if instance1.ID != instance2.ID {
+			return fmt.Errorf("Instance was recreated")
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckComputeInstanceState(
 	instance *servers.Server, state string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
@@ -1507,6 +1550,53 @@ resource "vkcs_compute_instance" "instance_1" {
 }
 `
 
+const testAccComputeInstanceAllowedAddressPairs1 = `
+{{.BaseNetwork}}
+{{.BaseImage}}
+{{.BaseFlavor}}
+{{.BaseSecurityGroup}}
+
+resource "vkcs_compute_instance" "instance_1" {
+  depends_on = ["vkcs_networking_router_interface.base"]
+  name = "instance_1"
+  availability_zone = "{{.AvailabilityZone}}"
+  security_group_ids = [data.vkcs_networking_secgroup.default_secgroup.id]
+  network {
+    uuid = vkcs_networking_network.base.id
+    allowed_address_pairs {
+      ip_address = "192.168.199.250"
+    }
+  }
+  image_id = data.vkcs_images_image.base.id
+  flavor_id = data.vkcs_compute_flavor.base.id
+}
+`
+
+const testAccComputeInstanceAllowedAddressPairs2 = `
+{{.BaseNetwork}}
+{{.BaseImage}}
+{{.BaseFlavor}}
+{{.BaseSecurityGroup}}
+
+resource "vkcs_compute_instance" "instance_1" {
+  depends_on = ["vkcs_networking_router_interface.base"]
+  name = "instance_1"
+  availability_zone = "{{.AvailabilityZone}}"
+  security_group_ids = [data.vkcs_networking_secgroup.default_secgroup.id]
+  network {
+    uuid = vkcs_networking_network.base.id
+    allowed_address_pairs {
+      ip_address = "192.168.199.250"
+    }
+    allowed_address_pairs {
+      ip_address = "192.168.199.251"
+    }
+  }
+  image_id = data.vkcs_images_image.base.id
+  flavor_id = data.vkcs_compute_flavor.base.id
+}
+`
+
 const testAccComputeInstanceStopBeforeDestroy = `
 {{.BaseNetwork}}
 {{.BaseImage}}
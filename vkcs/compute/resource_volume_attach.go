@@ -11,8 +11,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vk-cs/terraform-provider-vkcs/vkcs/blockstorage"
 	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/clients"
+	ivolumes "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/blockstorage/v3/volumes"
 	ivolumeattach "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/compute/v2/volumeattach"
 	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/util"
 )
@@ -51,7 +51,13 @@ func ResourceComputeVolumeAttach() *schema.Resource {
 				Type:        schema.TypeString,
 				Required:    true,
 				ForceNew:    true,
-				Description: "The ID of the Volume to attach to an Instance.",
+				Description: "The ID of the Volume to attach to an Instance. A multiattach volume can be attached to several instances with separate vkcs_compute_volume_attach resources.",
+			},
+
+			"device": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The device the volume is attached as.",
 			},
 		},
 		Description: "Attaches a Block Storage Volume to an Instance using the VKCS Compute API.",
@@ -77,6 +83,14 @@ func resourceComputeVolumeAttachCreate(ctx context.Context, d *schema.ResourceDa
 	instanceID := d.Get("instance_id").(string)
 	volumeID := d.Get("volume_id").(string)
 
+	v, err := ivolumes.Get(blockStorageClient, volumeID).Extract()
+	if err != nil {
+		return diag.Errorf("Error retrieving volume %s for vkcs_compute_volume_attach: %s", volumeID, err)
+	}
+	if v.Multiattach {
+		computeClient.Microversion = computeVolumeAttachMultiattachMicroversion
+	}
+
 	attachOpts := volumeattach.CreateOpts{
 		VolumeID: volumeID,
 	}
@@ -141,6 +155,7 @@ func resourceComputeVolumeAttachRead(_ context.Context, d *schema.ResourceData,
 
 	d.Set("instance_id", attachment.ServerID)
 	d.Set("volume_id", attachment.VolumeID)
+	d.Set("device", attachment.Device)
 	d.Set("region", util.GetRegion(d, config))
 
 	return nil
@@ -171,7 +186,9 @@ func resourceComputeVolumeAttachDelete(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(util.CheckDeleted(d, err, "Error detaching vkcs_compute_volume_attach"))
 	}
 
-	// Volume may be still in detaching status after detach resource is deleted
+	// Volume may be still in detaching status after detach resource is deleted.
+	// A multiattach volume stays in-use while it is attached to other instances,
+	// so wait only for the attachment to this instance to disappear.
 	blockStorageClient, err := config.BlockStorageV3Client(util.GetRegion(d, config))
 	if err != nil {
 		return diag.Errorf("Error creating VKCS block storage client: %s", err)
@@ -180,9 +197,9 @@ func resourceComputeVolumeAttachDelete(ctx context.Context, d *schema.ResourceDa
 	volumeID := d.Get("volume_id").(string)
 
 	volumeStateConf := &retry.StateChangeConf{
-		Pending:    []string{blockstorage.BSVolumeStatusDetaching, blockstorage.BSVolumeStatusInUse},
-		Target:     []string{blockstorage.BSVolumeStatusActive, blockstorage.BSVolumeStatusDeleted},
-		Refresh:    blockstorage.BlockStorageVolumeStateRefreshFunc(blockStorageClient, volumeID),
+		Pending:    []string{"DETACHING"},
+		Target:     []string{"DETACHED"},
+		Refresh:    computeVolumeAttachVolumeDetachFunc(blockStorageClient, instanceID, volumeID),
 		Timeout:    d.Timeout(schema.TimeoutDelete),
 		Delay:      5 * time.Second,
 		MinTimeout: 3 * time.Second,
@@ -67,6 +67,32 @@ func TestAccComputeVolumeAttach_basic(t *testing.T) {
 	})
 }
 
+func TestAccComputeVolumeAttach_multiattach(t *testing.T) {
+	var va1, va2 volumeattach.VolumeAttachment
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.AccTestPreCheck(t) },
+		ProviderFactories: acctest.AccTestProviders,
+		CheckDestroy:      testAccCheckComputeVolumeAttachDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.AccTestRenderConfig(testAccComputeVolumeAttachMultiattach),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeVolumeAttachExists("vkcs_compute_volume_attach.va_1", &va1),
+					testAccCheckComputeVolumeAttachExists("vkcs_compute_volume_attach.va_2", &va2),
+					resource.TestCheckResourceAttr("vkcs_blockstorage_volume.volume_1", "multiattach", "true"),
+				),
+			},
+			{
+				Config: acctest.AccTestRenderConfig(testAccComputeVolumeAttachMultiattach),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vkcs_blockstorage_volume.volume_1", "attachment.#", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckComputeVolumeAttachDestroy(s *terraform.State) error {
 	config := acctest.AccTestProvider.Meta().(clients.Config)
 	computeClient, err := config.ComputeV2Client(acctest.OsRegionName)
@@ -302,3 +328,52 @@ resource "vkcs_compute_volume_attach" "va_1" {
   volume_id = vkcs_blockstorage_volume.volume_1.id
 }
 `
+
+const testAccComputeVolumeAttachMultiattach = `
+{{.BaseNetwork}}
+{{.BaseImage}}
+{{.BaseFlavor}}
+{{.BaseSecurityGroup}}
+
+resource "vkcs_blockstorage_volume" "volume_1" {
+  name = "volume_1"
+  size = 1
+  multiattach = true
+  availability_zone = "{{.AvailabilityZone}}"
+  volume_type = "{{.VolumeType}}"
+}
+
+resource "vkcs_compute_instance" "instance_1" {
+  depends_on = ["vkcs_networking_router_interface.base"]
+  name = "instance_1"
+  availability_zone = "{{.AvailabilityZone}}"
+  security_group_ids = [data.vkcs_networking_secgroup.default_secgroup.id]
+  network {
+    uuid = vkcs_networking_network.base.id
+  }
+  image_id = data.vkcs_images_image.base.id
+  flavor_id = data.vkcs_compute_flavor.base.id
+}
+
+resource "vkcs_compute_instance" "instance_2" {
+  depends_on = ["vkcs_networking_router_interface.base"]
+  name = "instance_2"
+  availability_zone = "{{.AvailabilityZone}}"
+  security_group_ids = [data.vkcs_networking_secgroup.default_secgroup.id]
+  network {
+    uuid = vkcs_networking_network.base.id
+  }
+  image_id = data.vkcs_images_image.base.id
+  flavor_id = data.vkcs_compute_flavor.base.id
+}
+
+resource "vkcs_compute_volume_attach" "va_1" {
+  instance_id = vkcs_compute_instance.instance_1.id
+  volume_id = vkcs_blockstorage_volume.volume_1.id
+}
+
+resource "vkcs_compute_volume_attach" "va_2" {
+  instance_id = vkcs_compute_instance.instance_2.id
+  volume_id = vkcs_blockstorage_volume.volume_1.id
+}
+`
@@ -6,12 +6,19 @@ import (
 	"strings"
 
 	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/volumes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	ivolumes "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/blockstorage/v3/volumes"
 	ivolumeattach "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/compute/v2/volumeattach"
 	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/util/errutil"
 )
 
+const (
+	// computeVolumeAttachMultiattachMicroversion is the minimal compute API
+	// microversion that allows to attach a multiattach volume.
+	computeVolumeAttachMultiattachMicroversion = "2.60"
+)
+
 func ComputeVolumeAttachParseID(id string) (string, string, error) {
 	parts := strings.Split(id, "/")
 	if len(parts) < 2 {
@@ -41,7 +48,9 @@ func computeVolumeAttachAttachFunc(computeClient *gophercloud.ServiceClient, blo
 		if v.Status == "error" {
 			return va, "", fmt.Errorf("volume entered unexpected error status")
 		}
-		if v.Status != "in-use" {
+		// A multiattach volume stays in-use while it is attached to other
+		// instances, so the attachment to this instance has to be checked.
+		if v.Status != "in-use" || !computeVolumeAttachHasInstance(v.Attachments, instanceID) {
 			return va, "ATTACHING", nil
 		}
 
@@ -49,6 +58,35 @@ func computeVolumeAttachAttachFunc(computeClient *gophercloud.ServiceClient, blo
 	}
 }
 
+func computeVolumeAttachVolumeDetachFunc(blockStorageClient *gophercloud.ServiceClient, instanceID, volumeID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		v, err := ivolumes.Get(blockStorageClient, volumeID).Extract()
+		if err != nil {
+			if errutil.IsNotFound(err) {
+				return v, "DETACHED", nil
+			}
+			return nil, "", err
+		}
+		if v.Status == "error" {
+			return v, "", fmt.Errorf("volume entered unexpected error status")
+		}
+		if v.Status == "detaching" || computeVolumeAttachHasInstance(v.Attachments, instanceID) {
+			return v, "DETACHING", nil
+		}
+
+		return v, "DETACHED", nil
+	}
+}
+
+func computeVolumeAttachHasInstance(attachments []volumes.Attachment, instanceID string) bool {
+	for _, a := range attachments {
+		if a.ServerID == instanceID {
+			return true
+		}
+	}
+	return false
+}
+
 func computeVolumeAttachDetachFunc(computeClient *gophercloud.ServiceClient, instanceID, attachmentID string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		log.Printf("[DEBUG] vkcs_compute_volume_attach attempting to detach VKCS volume %s from instance %s",
@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"reflect"
+	"time"
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -181,6 +183,101 @@ func shardPathPrefix(d *schema.ResourceData, shardID string) (string, error) {
 	return "", nil
 }
 
+func findDatabaseClusterShard(shardsRaw []interface{}, shardID string) map[string]interface{} {
+	for _, shRaw := range shardsRaw {
+		sh := shRaw.(map[string]interface{})
+		if sh["shard_id"] == shardID {
+			return sh
+		}
+	}
+	return nil
+}
+
+// databaseClusterShardsDiff returns IDs of shards that are present only in
+// new and only in old lists of shards, preserving the order of the lists.
+func databaseClusterShardsDiff(oldShardsRaw, newShardsRaw []interface{}) (added []string, removed []string) {
+	for _, shRaw := range newShardsRaw {
+		shardID := shRaw.(map[string]interface{})["shard_id"].(string)
+		if findDatabaseClusterShard(oldShardsRaw, shardID) == nil {
+			added = append(added, shardID)
+		}
+	}
+	for _, shRaw := range oldShardsRaw {
+		shardID := shRaw.(map[string]interface{})["shard_id"].(string)
+		if findDatabaseClusterShard(newShardsRaw, shardID) == nil {
+			removed = append(removed, shardID)
+		}
+	}
+	return
+}
+
+// databaseClusterShardChange returns old and new values of the shard
+// attribute. Shards are matched by shard_id instead of their position, so
+// reordering of shards does not produce changes. Values are nil if the shard
+// is absent in the corresponding list.
+func databaseClusterShardChange(d *schema.ResourceData, shardID string, key string) (interface{}, interface{}) {
+	var oldValue, newValue interface{}
+	o, n := d.GetChange("shard")
+	if sh := findDatabaseClusterShard(o.([]interface{}), shardID); sh != nil {
+		oldValue = sh[key]
+	}
+	if sh := findDatabaseClusterShard(n.([]interface{}), shardID); sh != nil {
+		newValue = sh[key]
+	}
+	return oldValue, newValue
+}
+
+func databaseClusterShardHasChange(d *schema.ResourceData, shardID string, key string) bool {
+	o, n := databaseClusterShardChange(d, shardID, key)
+	return !reflect.DeepEqual(o, n)
+}
+
+func checkDatabaseClusterShardIDs(shardsRaw []interface{}) error {
+	seen := make(map[string]struct{}, len(shardsRaw))
+	for _, shRaw := range shardsRaw {
+		sh, ok := shRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		shardID, _ := sh["shard_id"].(string)
+		// Unknown values are empty at plan time
+		if shardID == "" {
+			continue
+		}
+		if _, ok := seen[shardID]; ok {
+			return fmt.Errorf("shard_id %q is used by more than one shard", shardID)
+		}
+		seen[shardID] = struct{}{}
+	}
+	return nil
+}
+
+func databaseClusterShardNetworksEqual(o, n []interface{}) bool {
+	if len(o) != len(n) {
+		return false
+	}
+	for i := range o {
+		oNet, _ := o[i].(map[string]interface{})
+		nNet, _ := n[i].(map[string]interface{})
+		for _, k := range []string{"uuid", "port", "subnet_id"} {
+			if oNet[k] != nNet[k] {
+				return false
+			}
+		}
+		oSecGroups, nSecGroups := schema.NewSet(schema.HashString, nil), schema.NewSet(schema.HashString, nil)
+		if v, ok := oNet["security_groups"].(*schema.Set); ok && v != nil {
+			oSecGroups = v
+		}
+		if v, ok := nNet["security_groups"].(*schema.Set); ok && v != nil {
+			nSecGroups = v
+		}
+		if !oSecGroups.Equal(nSecGroups) {
+			return false
+		}
+	}
+	return true
+}
+
 func databaseClusterCheckDeleted(d *schema.ResourceData, err error) error {
 	if errutil.IsNotFound(err) {
 		d.SetId("")
@@ -214,6 +311,8 @@ var (
 	errDBClusterUpdateWalDiskAutoexpand        = errors.New("error updating wal_disk_autoexpand")
 	errDBClusterUpdateWalDiskAutoexpandExtract = errors.New("unable to determine wal_disk_autoexpand")
 	errDBClusterUpdateCloudMonitoring          = errors.New("error updating cloud_monitoring_enabled")
+	errDBClusterUpdateMaintenanceWindow        = errors.New("error updating maintenance_window")
+	errDBClusterUpdateMaintenanceWindowExtract = errors.New("unable to determine maintenance_window")
 
 	errDBClusterActionUpdateConfiguration      = errors.New("error updating configuration for cluster")
 	errDBClusterActionApplyCapabitilies        = errors.New("error applying capabilities")
@@ -223,9 +322,13 @@ var (
 	errDBClusterActionShrink                   = errors.New("error shrinking cluster")
 	errDBClusterActionShrinkWrongOptions       = errors.New("invalid shrink options")
 	errDBClusterActionShrinkInstancesExtract   = errors.New("error determining instances to shrink")
+	errDBClusterActionRemoveShard              = errors.New("error removing shard")
 	errDBClusterActionResizeVolume             = errors.New("error resizing volume")
 	errDBClusterActionResizeWalVolume          = errors.New("error resizing wal_volume")
 	errDBClusterActionResizeFlavor             = errors.New("error resizing flavor")
+	errDBClusterActionRestart                  = errors.New("error restarting cluster instance")
+	errDBClusterActionSwitchover               = errors.New("error switching over cluster")
+	errDBClusterActionUpgrade                  = errors.New("error upgrading datastore version of cluster")
 )
 
 func databaseClusterActionUpdateConfiguration(updateCtx *dbResourceUpdateContext) error {
@@ -368,6 +471,22 @@ func databaseClusterUpdateCloudMonitoringBase(updateCtx *dbResourceUpdateContext
 	return nil
 }
 
+func databaseClusterUpdateMaintenanceWindow(updateCtx *dbResourceUpdateContext) error {
+	clusterID := updateCtx.D.Id()
+	maintenanceWindow, err := extractDatabaseMaintenanceWindow(updateCtx.D.Get("maintenance_window").([]interface{}))
+	if err != nil {
+		return errDBClusterUpdateMaintenanceWindowExtract
+	}
+	var maintenanceWindowOpts clusters.UpdateMaintenanceWindowOpts
+	maintenanceWindowOpts.Cluster.MaintenanceWindow = maintenanceWindow
+	err = clusters.Update(updateCtx.Client, clusterID, &maintenanceWindowOpts).ExtractErr()
+	if err != nil {
+		return fmt.Errorf("%w: %s", errDBClusterUpdateMaintenanceWindow, err)
+	}
+	log.Printf("[DEBUG] Updated maintenance_window in cluster %s", clusterID)
+	return nil
+}
+
 func databaseClusterActionApplyCapabilities(updateCtx *dbResourceUpdateContext) error {
 	dbClient, clusterID := updateCtx.Client, updateCtx.D.Id()
 
@@ -429,11 +548,20 @@ func databaseClusterActionGrow(updateCtx *dbResourceUpdateContext, shardID strin
 
 	var old, new interface{}
 	if shardID != "" {
-		old, new = d.GetChange(pathPrefix + "size")
+		old, new = databaseClusterShardChange(d, shardID, "size")
 	} else {
 		old, new = d.GetChange("cluster_size")
 	}
-	growSize := new.(int) - old.(int)
+	// Old size is absent when a new shard is added
+	oldSize, isExisting := old.(int)
+	growSize := new.(int) - oldSize
+
+	if shardID != "" && !isExisting {
+		growOpts.Nics, growOpts.SecurityGroups, err = extractDatabaseNetworks(d.Get(pathPrefix + "network").([]interface{}))
+		if err != nil {
+			return fmt.Errorf("%w: unable to determine network of shard: %s", errDBClusterActionGrow, err)
+		}
+	}
 
 	if shardID != "" {
 		updateCtx.StateConf.Pending = []string{string(clusterStatusGrow), string(clusterStatusBuild)}
@@ -471,7 +599,7 @@ func databaseClusterActionShrink(updateCtx *dbResourceUpdateContext, shardID str
 
 	var old, new interface{}
 	if shardID != "" {
-		old, new = d.GetChange(pathPrefix + "size")
+		old, new = databaseClusterShardChange(d, shardID, "size")
 	} else {
 		old, new = d.GetChange("cluster_size")
 	}
@@ -504,6 +632,36 @@ func databaseClusterActionShrink(updateCtx *dbResourceUpdateContext, shardID str
 	return databaseClusterActionShrinkBase(updateCtx, ids)
 }
 
+// databaseClusterActionRemoveShard removes all instances of the shard that
+// is absent in the new configuration.
+func databaseClusterActionRemoveShard(updateCtx *dbResourceUpdateContext, shardID string) error {
+	d := updateCtx.D
+	cluster, err := clusters.Get(updateCtx.Client, d.Id()).Extract()
+	if err != nil {
+		return databaseClusterCheckDeleted(d, err)
+	}
+
+	ids := []clusters.ShrinkOpts{}
+	for _, inst := range cluster.Instances {
+		if inst.ShardID == shardID {
+			ids = append(ids, clusters.ShrinkOpts{ID: inst.ID})
+		}
+	}
+	if len(ids) == 0 {
+		log.Printf("[DEBUG] Shard %s of cluster %s has no instances to remove", shardID, d.Id())
+		return nil
+	}
+
+	updateCtx.StateConf.Pending = []string{string(clusterStatusShrink), string(clusterStatusBuild)}
+	updateCtx.StateConf.Target = []string{string(clusterStatusActive)}
+
+	err = databaseClusterActionShrinkBase(updateCtx, ids)
+	if err != nil && errors.Is(err, errDBClusterActionShrink) {
+		return fmt.Errorf("%w: %s", errDBClusterActionRemoveShard, err)
+	}
+	return err
+}
+
 func databaseClusterActionShrinkBase(updateCtx *dbResourceUpdateContext, shrinkOpts []clusters.ShrinkOpts) error {
 	clusterID := updateCtx.D.Id()
 	shrinkClusterOpts := clusters.ShrinkClusterOpts{
@@ -549,12 +707,16 @@ func databaseClusterActionResizeVolumeBase(updateCtx *dbResourceUpdateContext, o
 
 func databaseClusterActionResizeWalVolume(updateCtx *dbResourceUpdateContext, shardID string) error {
 	d := updateCtx.D
-	pathPrefix, err := shardPathPrefix(d, shardID)
-	if err != nil {
+	if _, err := shardPathPrefix(d, shardID); err != nil {
 		return err
 	}
 
-	old, new := d.GetChange(pathPrefix + "wal_volume")
+	var old, new interface{}
+	if shardID != "" {
+		old, new = databaseClusterShardChange(d, shardID, "wal_volume")
+	} else {
+		old, new = d.GetChange("wal_volume")
+	}
 	walVolumeOptsNew, err := extractDatabaseWalVolume(new.([]interface{}))
 	if err != nil {
 		return errDBClusterActionResizeWalVolumeExtract
@@ -617,6 +779,141 @@ func databaseClusterActionResizeFlavorBase(updateCtx *dbResourceUpdateContext, o
 	return updateCtx.WaitForStateContext()
 }
 
+// databaseClusterRestartOrder returns cluster instances in the order of a
+// rolling restart: the leader is restarted last to minimize the number of
+// role changes.
+func databaseClusterRestartOrder(insts []clusters.ClusterInstanceResp) []clusters.ClusterInstanceResp {
+	ordered := make([]clusters.ClusterInstanceResp, 0, len(insts))
+	var leaders []clusters.ClusterInstanceResp
+	for _, inst := range insts {
+		if inst.Role == ClusterInstanceRoleLeader {
+			leaders = append(leaders, inst)
+			continue
+		}
+		ordered = append(ordered, inst)
+	}
+	return append(ordered, leaders...)
+}
+
+func databaseClusterActionRestart(updateCtx *dbResourceUpdateContext) error {
+	clusterID := updateCtx.D.Id()
+	cluster, err := clusters.Get(updateCtx.Client, clusterID).Extract()
+	if err != nil {
+		return fmt.Errorf("%w: %s", errDBClusterNotFound, err)
+	}
+
+	updateCtx.StateConf.Pending = []string{string(clusterStatusUpdating), string(clusterStatusBuild)}
+	updateCtx.StateConf.Target = []string{string(clusterStatusActive)}
+
+	for _, inst := range databaseClusterRestartOrder(cluster.Instances) {
+		var restartOpts instances.RestartOpts
+		err := instances.Action(updateCtx.Client, inst.ID, &restartOpts).ExtractErr()
+		if err != nil {
+			return fmt.Errorf("%w: %s: %s", errDBClusterActionRestart, inst.ID, err)
+		}
+		log.Printf("[DEBUG] Restarting instance %s of cluster %s", inst.ID, clusterID)
+
+		err = databaseClusterWaitForInstanceRestart(updateCtx, inst.ID)
+		if err != nil {
+			return err
+		}
+
+		err = updateCtx.WaitForStateContext()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// databaseClusterWaitForInstanceRestart waits for the instance to start
+// restarting and then to become active again, so the next instance of the
+// cluster is not restarted while the previous one is still restarting.
+func databaseClusterWaitForInstanceRestart(updateCtx *dbResourceUpdateContext, instanceID string) error {
+	startConf, finishConf := databaseClusterInstanceRestartStateConfs(
+		databaseInstanceStateRefreshFunc(updateCtx.Client, instanceID, nil), updateCtx.StateConf.Timeout)
+
+	_, err := startConf.WaitForStateContext(updateCtx.Ctx)
+	var timeoutErr *retry.TimeoutError
+	if errors.As(err, &timeoutErr) {
+		// The restart may be too quick to be noticed.
+		log.Printf("[DEBUG] Instance %s did not report restart within %s", instanceID, dbActionStartTimeout)
+	} else if err != nil {
+		return fmt.Errorf("%w: %s", errDBClusterUpdateWait, err)
+	}
+
+	_, err = finishConf.WaitForStateContext(updateCtx.Ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errDBClusterUpdateWait, err)
+	}
+
+	return nil
+}
+
+// databaseClusterInstanceRestartStateConfs returns waits for an instance to
+// start and to finish its restart. An instance that waits for a restart of
+// its DBMS reports RESTART_REQUIRED until the restart begins.
+func databaseClusterInstanceRestartStateConfs(refresh retry.StateRefreshFunc, timeout time.Duration) (*retry.StateChangeConf, *retry.StateChangeConf) {
+	restartingStatuses := []string{string(dbInstanceStatusReboot), string(dbInstanceStatusShutdown), string(dbInstanceStatusBuild)}
+
+	startConf := &retry.StateChangeConf{
+		Pending:    []string{string(dbInstanceStatusActive), string(dbInstanceStatusRestartRequired)},
+		Target:     restartingStatuses,
+		Refresh:    refresh,
+		Timeout:    dbActionStartTimeout,
+		Delay:      dbInstanceMinTimeout,
+		MinTimeout: dbInstanceMinTimeout,
+	}
+	finishConf := &retry.StateChangeConf{
+		Pending:    append(restartingStatuses, string(dbInstanceStatusRestartRequired)),
+		Target:     []string{string(dbInstanceStatusActive)},
+		Refresh:    refresh,
+		Timeout:    timeout,
+		Delay:      dbInstanceDelay,
+		MinTimeout: dbInstanceMinTimeout,
+	}
+
+	return startConf, finishConf
+}
+
+func databaseClusterActionSwitchover(updateCtx *dbResourceUpdateContext) error {
+	clusterID := updateCtx.D.Id()
+	var switchoverOpts clusters.SwitchoverOpts
+	err := clusters.ClusterAction(updateCtx.Client, clusterID, &switchoverOpts).ExtractErr()
+	if err != nil {
+		return fmt.Errorf("%w: %s", errDBClusterActionSwitchover, err)
+	}
+	log.Printf("[DEBUG] Switching over cluster %s", clusterID)
+
+	updateCtx.StateConf.Pending = []string{string(clusterStatusUpdating), string(clusterStatusBuild)}
+	updateCtx.StateConf.Target = []string{string(clusterStatusActive)}
+
+	return updateCtx.WaitForStateContext()
+}
+
+func databaseClusterActionUpgrade(updateCtx *dbResourceUpdateContext) error {
+	clusterID := updateCtx.D.Id()
+	var upgradeOpts clusters.UpgradeOpts
+	upgradeOpts.Upgrade.DatastoreVersion = updateCtx.D.Get("datastore.0.version").(string)
+	err := clusters.ClusterAction(updateCtx.Client, clusterID, &upgradeOpts).ExtractErr()
+	if err != nil {
+		return fmt.Errorf("%w: %s", errDBClusterActionUpgrade, err)
+	}
+	log.Printf("[DEBUG] Upgrading datastore version of cluster %s to %s", clusterID, upgradeOpts.Upgrade.DatastoreVersion)
+
+	upgradeStatuses := []string{string(clusterStatusUpgrade), string(clusterStatusUpdating), string(clusterStatusBuild)}
+	err = waitForDatabaseActionStart(updateCtx.Ctx, updateCtx.StateConf.Refresh, string(clusterStatusActive), upgradeStatuses)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errDBClusterUpdateWait, err)
+	}
+
+	updateCtx.StateConf.Pending = upgradeStatuses
+	updateCtx.StateConf.Target = []string{string(clusterStatusActive)}
+
+	return updateCtx.WaitForStateContext()
+}
+
 func databaseClusterActionEnableRoot(updateCtx *dbResourceUpdateContext) diag.Diagnostics {
 	clusterID := updateCtx.D.Id()
 	rootPassword := updateCtx.D.Get("root_password")
@@ -644,7 +941,8 @@ func getClusterStatus(c *clusters.ClusterResp) string {
 		if inst.Status == string(dbInstanceStatusError) {
 			return inst.Status
 		}
-		if inst.Status == string(dbInstanceStatusBuild) || inst.Status == string(dbInstanceStatusResize) {
+		if inst.Status == string(dbInstanceStatusBuild) || inst.Status == string(dbInstanceStatusResize) ||
+			inst.Status == string(dbInstanceStatusReboot) || inst.Status == string(dbInstanceStatusUpgrade) {
 			instancesStatus = inst.Status
 		}
 	}
@@ -659,6 +957,8 @@ func getClusterStatus(c *clusters.ClusterResp) string {
 			return string(clusterStatusBuild)
 		case string(dbInstanceStatusResize):
 			return string(clusterStatusResize)
+		case string(dbInstanceStatusReboot), string(dbInstanceStatusUpgrade):
+			return string(clusterStatusUpdating)
 		}
 	}
 
@@ -0,0 +1,171 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/db/v1/clusters"
+)
+
+func TestDatabaseClusterRestartOrder(t *testing.T) {
+	insts := []clusters.ClusterInstanceResp{
+		{ID: "1", Role: "replica"},
+		{ID: "2", Role: ClusterInstanceRoleLeader},
+		{ID: "3", Role: "sync_replica"},
+	}
+
+	ordered := databaseClusterRestartOrder(insts)
+
+	ids := make([]string, len(ordered))
+	for i, inst := range ordered {
+		ids[i] = inst.ID
+	}
+	assert.Equal(t, []string{"1", "3", "2"}, ids)
+}
+
+func TestGetClusterStatusReboot(t *testing.T) {
+	c := &clusters.ClusterResp{
+		Task: clusters.Task{Name: "NONE"},
+		Instances: []clusters.ClusterInstanceResp{
+			{ID: "1", Status: string(dbInstanceStatusActive)},
+			{ID: "2", Status: string(dbInstanceStatusReboot)},
+		},
+	}
+	assert.Equal(t, string(clusterStatusUpdating), getClusterStatus(c))
+
+	c.Instances[1].Status = string(dbInstanceStatusActive)
+	assert.Equal(t, string(clusterStatusActive), getClusterStatus(c))
+}
+
+func TestDatabaseClusterShardsDiff(t *testing.T) {
+	shard := func(id string) interface{} {
+		return map[string]interface{}{"shard_id": id}
+	}
+	oldShards := []interface{}{shard("shard0"), shard("shard1"), shard("shard2")}
+	newShards := []interface{}{shard("shard2"), shard("shard3"), shard("shard0")}
+
+	added, removed := databaseClusterShardsDiff(oldShards, newShards)
+	assert.Equal(t, []string{"shard3"}, added)
+	assert.Equal(t, []string{"shard1"}, removed)
+
+	added, removed = databaseClusterShardsDiff(oldShards, []interface{}{shard("shard2"), shard("shard1"), shard("shard0")})
+	assert.Empty(t, added)
+	assert.Empty(t, removed)
+}
+
+func TestCheckDatabaseClusterShardIDs(t *testing.T) {
+	shard := func(id string) interface{} {
+		return map[string]interface{}{"shard_id": id}
+	}
+	assert.NoError(t, checkDatabaseClusterShardIDs([]interface{}{shard("shard0"), shard("shard1"), shard("")}))
+	assert.Error(t, checkDatabaseClusterShardIDs([]interface{}{shard("shard0"), shard("shard1"), shard("shard0")}))
+}
+
+func TestDatabaseClusterShardNetworksEqual(t *testing.T) {
+	network := func(uuid string, secGroups ...interface{}) interface{} {
+		return map[string]interface{}{
+			"uuid":            uuid,
+			"port":            "",
+			"subnet_id":       "",
+			"security_groups": schema.NewSet(schema.HashString, secGroups),
+		}
+	}
+	assert.True(t, databaseClusterShardNetworksEqual(
+		[]interface{}{network("net", "sg1", "sg2")}, []interface{}{network("net", "sg2", "sg1")}))
+	assert.False(t, databaseClusterShardNetworksEqual(
+		[]interface{}{network("net", "sg1")}, []interface{}{network("net", "sg2")}))
+	assert.False(t, databaseClusterShardNetworksEqual(
+		[]interface{}{network("net")}, []interface{}{network("other")}))
+	assert.False(t, databaseClusterShardNetworksEqual(
+		[]interface{}{network("net")}, []interface{}{}))
+}
+
+func TestResourceDatabaseClusterWithShardsCustomizeDiffForceNew(t *testing.T) {
+	r := ResourceDatabaseClusterWithShards()
+	shard := func(id, az, network string) map[string]interface{} {
+		return map[string]interface{}{
+			"shard_id":          id,
+			"size":              1,
+			"flavor_id":         "flavor",
+			"volume_size":       10,
+			"volume_type":       "ceph-ssd",
+			"availability_zone": az,
+			"network":           []interface{}{map[string]interface{}{"uuid": network}},
+		}
+	}
+	config := func(shards ...interface{}) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"name":      "cluster",
+			"datastore": []interface{}{map[string]interface{}{"type": "clickhouse", "version": "24.3"}},
+			"shard":     shards,
+		})
+	}
+
+	d := r.TestResourceData()
+	d.SetId("cluster")
+	assert.NoError(t, d.Set("name", "cluster"))
+	assert.NoError(t, d.Set("datastore", []interface{}{map[string]interface{}{"type": "clickhouse", "version": "24.3"}}))
+	assert.NoError(t, d.Set("shard", []interface{}{shard("shard0", "GZ1", "net"), shard("shard1", "GZ1", "net")}))
+	state := d.State()
+
+	// Reordering and adding shards is done in place
+	diff, err := r.Diff(context.Background(), state, config(
+		shard("shard1", "GZ1", "net"), shard("shard0", "GZ1", "net"), shard("shard2", "MS1", "other")), nil)
+	assert.NoError(t, err)
+	assert.False(t, diff.RequiresNew())
+
+	diff, err = r.Diff(context.Background(), state, config(
+		shard("shard0", "GZ1", "net"), shard("shard1", "MS1", "net")), nil)
+	assert.NoError(t, err)
+	assert.True(t, diff.RequiresNew())
+	assert.True(t, diff.Attributes["shard.1.availability_zone"].RequiresNew)
+
+	diff, err = r.Diff(context.Background(), state, config(
+		shard("shard0", "GZ1", "other"), shard("shard1", "GZ1", "net")), nil)
+	assert.NoError(t, err)
+	assert.True(t, diff.RequiresNew())
+	assert.True(t, diff.Attributes["shard.0.network.0.uuid"].RequiresNew)
+
+	// Availability zones swapped along with the order of shards show no
+	// change by index
+	assert.NoError(t, d.Set("shard", []interface{}{shard("shard0", "GZ1", "net"), shard("shard1", "MS1", "net")}))
+	_, err = r.Diff(context.Background(), d.State(), config(
+		shard("shard1", "GZ1", "net"), shard("shard0", "MS1", "net")), nil)
+	assert.Error(t, err)
+}
+
+func TestDatabaseClusterInstanceRestartStateConfs(t *testing.T) {
+	statuses := []dbInstanceStatus{
+		dbInstanceStatusRestartRequired,
+		dbInstanceStatusRestartRequired,
+		dbInstanceStatusReboot,
+		dbInstanceStatusRestartRequired,
+		dbInstanceStatusActive,
+	}
+	refresh := func() (interface{}, string, error) {
+		status := statuses[0]
+		if len(statuses) > 1 {
+			statuses = statuses[1:]
+		}
+		return status, string(status), nil
+	}
+
+	startConf, finishConf := databaseClusterInstanceRestartStateConfs(refresh, time.Minute)
+	for _, conf := range []*retry.StateChangeConf{startConf, finishConf} {
+		conf.Delay = 0
+		conf.PollInterval = time.Millisecond
+	}
+
+	status, err := startConf.WaitForStateContext(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, dbInstanceStatusReboot, status)
+
+	status, err = finishConf.WaitForStateContext(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, dbInstanceStatusActive, status)
+}
@@ -2,7 +2,9 @@ package db
 
 import (
 	"fmt"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/db/v1/datastores"
@@ -60,6 +62,102 @@ func getDSParameterTypesMap(dsParameters []datastores.Param) map[string]string {
 	return dsParameterTypes
 }
 
+// checkDatabaseConfigGroupValues checks that values are known parameters of
+// the datastore, have proper types and fit into parameter limits.
+func checkDatabaseConfigGroupValues(rawValues map[string]interface{}, dsParameters []datastores.Param) error {
+	values, err := extractDatabaseConfigGroupValues(rawValues, getDSParameterTypesMap(dsParameters))
+	if err != nil {
+		return err
+	}
+
+	for _, dsParameter := range dsParameters {
+		value, ok := values[dsParameter.Name]
+		if !ok || dsParameter.MinValue == 0 && dsParameter.MaxValue == 0 {
+			continue
+		}
+
+		var v float64
+		switch value := value.(type) {
+		case int:
+			v = float64(value)
+		case float64:
+			v = value
+		default:
+			continue
+		}
+
+		if v < dsParameter.MinValue || v > dsParameter.MaxValue {
+			return fmt.Errorf("value of parameter %s must be between %v and %v, got %v",
+				dsParameter.Name, dsParameter.MinValue, dsParameter.MaxValue, value)
+		}
+	}
+
+	return nil
+}
+
+// getDatabaseConfigGroupRestartRequired returns sorted names of changed
+// parameters, which require a restart of instances to be applied.
+func getDatabaseConfigGroupRestartRequired(oldValues, newValues map[string]interface{}, dsParameters []datastores.Param) []string {
+	var names []string
+	for _, dsParameter := range dsParameters {
+		if !dsParameter.RestartRequried {
+			continue
+		}
+		oldValue, oldOk := oldValues[dsParameter.Name]
+		newValue, newOk := newValues[dsParameter.Name]
+		if oldOk != newOk || oldOk && !databaseConfigGroupValuesEqual(oldValue.(string), newValue.(string), dsParameter.Type) {
+			names = append(names, dsParameter.Name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func databaseConfigGroupRestartRequiredMessage(restartRequired []string) string {
+	return fmt.Sprintf("Changes of parameters %s are applied only after a restart of instances the config group is attached to. "+
+		"Set \"restart_confirmed\" in \"vendor_options\" of the instances to allow the restart.", strings.Join(restartRequired, ", "))
+}
+
+// databaseConfigGroupValuesEqual reports whether two raw values are equal
+// once converted to the parameter type, e.g. "True" and "true" for boolean
+// parameters or "1.50" and "1.5" for float parameters. Values of string
+// parameters and parameters of unknown type are compared as is.
+func databaseConfigGroupValuesEqual(a, b, vType string) bool {
+	if a == b {
+		return true
+	}
+	switch vType {
+	case "integer":
+		aInt, aErr := strconv.Atoi(a)
+		bInt, bErr := strconv.Atoi(b)
+		return aErr == nil && bErr == nil && aInt == bInt
+	case "boolean":
+		aBool, aErr := strconv.ParseBool(a)
+		bBool, bErr := strconv.ParseBool(b)
+		return aErr == nil && bErr == nil && aBool == bBool
+	case "float":
+		aFloat, aErr := strconv.ParseFloat(a, 64)
+		bFloat, bErr := strconv.ParseFloat(b, 64)
+		return aErr == nil && bErr == nil && aFloat == bFloat
+	}
+	return false
+}
+
+// normalizeDatabaseConfigGroupValues keeps prior raw values, which are equal
+// to values returned by the API once converted to the parameter type, so
+// that e.g. "True" in the configuration does not differ from "true" read
+// back from the API.
+func normalizeDatabaseConfigGroupValues(rawValues, priorValues map[string]interface{}, dsParameterTypes map[string]string) map[string]interface{} {
+	values := make(map[string]interface{}, len(rawValues))
+	for name, value := range rawValues {
+		values[name] = value
+		if prior, ok := priorValues[name].(string); ok && databaseConfigGroupValuesEqual(prior, value.(string), dsParameterTypes[name]) {
+			values[name] = prior
+		}
+	}
+	return values
+}
+
 func retrieveDatabaseConfigGroupValues(client *gophercloud.ServiceClient, datastore datastores.DatastoreShort, v map[string]interface{}) (map[string]interface{}, error) {
 	dsParameters, err := datastores.ListParameters(client, datastore.Type, datastore.Version).Extract()
 	if err != nil {
@@ -0,0 +1,88 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/db/v1/datastores"
+)
+
+var testDatabaseConfigGroupParameters = []datastores.Param{
+	{Name: "max_connections", Type: "integer", MinValue: 10, MaxValue: 1000, RestartRequried: true},
+	{Name: "autovacuum", Type: "boolean"},
+	{Name: "random_page_cost", Type: "float", MinValue: 0, MaxValue: 10},
+	{Name: "timezone", Type: "string"},
+}
+
+func TestCheckDatabaseConfigGroupValues(t *testing.T) {
+	valid := map[string]interface{}{
+		"max_connections":  "100",
+		"autovacuum":       "True",
+		"random_page_cost": "1.50",
+		"timezone":         "UTC",
+	}
+	assert.NoError(t, checkDatabaseConfigGroupValues(valid, testDatabaseConfigGroupParameters))
+
+	assert.Error(t, checkDatabaseConfigGroupValues(map[string]interface{}{"max_conections": "100"}, testDatabaseConfigGroupParameters))
+	assert.Error(t, checkDatabaseConfigGroupValues(map[string]interface{}{"max_connections": "many"}, testDatabaseConfigGroupParameters))
+	assert.Error(t, checkDatabaseConfigGroupValues(map[string]interface{}{"max_connections": "5"}, testDatabaseConfigGroupParameters))
+	assert.Error(t, checkDatabaseConfigGroupValues(map[string]interface{}{"random_page_cost": "10.5"}, testDatabaseConfigGroupParameters))
+}
+
+func TestDatabaseConfigGroupValuesEqual(t *testing.T) {
+	assert.True(t, databaseConfigGroupValuesEqual("True", "true", "boolean"))
+	assert.True(t, databaseConfigGroupValuesEqual("1", "true", "boolean"))
+	assert.True(t, databaseConfigGroupValuesEqual("1.50", "1.5", "float"))
+	assert.True(t, databaseConfigGroupValuesEqual("010", "10", "integer"))
+	assert.False(t, databaseConfigGroupValuesEqual("0", "true", "boolean"))
+	assert.False(t, databaseConfigGroupValuesEqual("10", "100", "integer"))
+	assert.False(t, databaseConfigGroupValuesEqual("1.5", "1.50x", "float"))
+	assert.False(t, databaseConfigGroupValuesEqual("UTC", "utc", "string"))
+	assert.False(t, databaseConfigGroupValuesEqual("1", "true", "string"))
+	assert.False(t, databaseConfigGroupValuesEqual("010", "10", "string"))
+	assert.False(t, databaseConfigGroupValuesEqual("010", "10", ""))
+}
+
+func TestNormalizeDatabaseConfigGroupValues(t *testing.T) {
+	rawValues := map[string]interface{}{
+		"max_connections":  "10",
+		"autovacuum":       "true",
+		"random_page_cost": "1.5",
+		"timezone":         "utc",
+	}
+	priorValues := map[string]interface{}{
+		"max_connections":  "20",
+		"autovacuum":       "True",
+		"random_page_cost": "1.50",
+		"timezone":         "UTC",
+	}
+
+	values := normalizeDatabaseConfigGroupValues(rawValues, priorValues, getDSParameterTypesMap(testDatabaseConfigGroupParameters))
+	assert.Equal(t, map[string]interface{}{
+		"max_connections":  "10",
+		"autovacuum":       "True",
+		"random_page_cost": "1.50",
+		"timezone":         "utc",
+	}, values)
+
+	// Values are kept as is without prior values, e.g. after import
+	assert.Equal(t, rawValues, normalizeDatabaseConfigGroupValues(rawValues, nil, getDSParameterTypesMap(testDatabaseConfigGroupParameters)))
+}
+
+func TestGetDatabaseConfigGroupRestartRequired(t *testing.T) {
+	oldValues := map[string]interface{}{
+		"max_connections": "100",
+		"autovacuum":      "true",
+	}
+	newValues := map[string]interface{}{
+		"max_connections": "100",
+		"autovacuum":      "false",
+	}
+	assert.Empty(t, getDatabaseConfigGroupRestartRequired(oldValues, newValues, testDatabaseConfigGroupParameters))
+
+	newValues["max_connections"] = "200"
+	assert.Equal(t, []string{"max_connections"}, getDatabaseConfigGroupRestartRequired(oldValues, newValues, testDatabaseConfigGroupParameters))
+
+	delete(newValues, "max_connections")
+	assert.Equal(t, []string{"max_connections"}, getDatabaseConfigGroupRestartRequired(oldValues, newValues, testDatabaseConfigGroupParameters))
+}
@@ -0,0 +1,144 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/clients"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/db/v1/instances"
+)
+
+const dbInstanceLogDefaultLines = 50
+
+var (
+	_ datasource.DataSource              = &InstanceLogDataSource{}
+	_ datasource.DataSourceWithConfigure = &InstanceLogDataSource{}
+)
+
+func NewInstanceLogDataSource() datasource.DataSource {
+	return &InstanceLogDataSource{}
+}
+
+type InstanceLogDataSource struct {
+	config clients.Config
+}
+
+type InstanceLogDataSourceModel struct {
+	ID     types.String `tfsdk:"id"`
+	Region types.String `tfsdk:"region"`
+
+	InstanceID types.String `tfsdk:"instance_id"`
+	Name       types.String `tfsdk:"name"`
+	Lines      types.Int64  `tfsdk:"lines"`
+	Content    []string     `tfsdk:"content"`
+}
+
+func (d *InstanceLogDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "vkcs_db_instance_log"
+}
+
+func (d *InstanceLogDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the resource.",
+			},
+
+			"region": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The region to obtain the service client. If omitted, the `region` argument of the provider is used.",
+			},
+
+			"instance_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the instance.",
+			},
+
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the log, e.g. `slow_query`. The log must be published, see `published_logs` argument of `vkcs_db_instance`.",
+			},
+
+			"lines": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				Description: fmt.Sprintf("Number of latest lines of the log to return. Defaults to %d.", dbInstanceLogDefaultLines),
+			},
+
+			"content": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Latest lines of the log, from oldest to newest.",
+			},
+		},
+		Description: "Use this data source to get latest lines of a published log of a VKCS db instance.",
+	}
+}
+
+func (d *InstanceLogDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.config = req.ProviderData.(clients.Config)
+}
+
+func (d *InstanceLogDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data InstanceLogDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	region := data.Region.ValueString()
+	if region == "" {
+		region = d.config.GetRegion()
+	}
+
+	client, err := d.config.DatabaseV1Client(region)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating VKCS Databases API client", err.Error())
+		return
+	}
+
+	lines := data.Lines.ValueInt64()
+	if data.Lines.IsNull() || data.Lines.IsUnknown() {
+		lines = dbInstanceLogDefaultLines
+	}
+
+	instanceID, name := data.InstanceID.ValueString(), data.Name.ValueString()
+	ctx = tflog.SetField(ctx, "instance_id", instanceID)
+	ctx = tflog.SetField(ctx, "name", name)
+
+	tflog.Debug(ctx, "Calling Databases API to get content of the instance log")
+
+	logContent, err := instances.GetLogContent(client, instanceID, name, instances.GetLogContentOpts{Lines: int(lines)}).Extract()
+	if err != nil {
+		resp.Diagnostics.AddError("Error calling VKCS Databases API", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Called Databases API to get content of the instance log")
+
+	data.ID = types.StringValue(fmt.Sprintf("%s/logs/%s", instanceID, name))
+	data.Region = types.StringValue(region)
+	data.Lines = types.Int64Value(lines)
+	data.Content = []string{}
+	if logContent != nil && logContent.Lines != nil {
+		data.Content = logContent.Lines
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
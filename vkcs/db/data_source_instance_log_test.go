@@ -0,0 +1,37 @@
+package db_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/acctest"
+)
+
+func TestAccDatabaseInstanceLogDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.AccTestProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDatabaseInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.AccTestRenderConfig(testAccDatabaseInstanceLogDataSourceBasic, map[string]string{"TestAccDatabaseInstanceWithPublishedLogs": acctest.AccTestRenderConfig(testAccDatabaseInstanceWithPublishedLogs)}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vkcs_db_instance.with_logs", "published_logs.#", "1"),
+					resource.TestCheckResourceAttr("data.vkcs_db_instance_log.slow_query", "name", "slow_query"),
+					resource.TestCheckResourceAttr("data.vkcs_db_instance_log.slow_query", "lines", "10"),
+					resource.TestCheckResourceAttrSet("data.vkcs_db_instance_log.slow_query", "content.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDatabaseInstanceLogDataSourceBasic = `
+{{.TestAccDatabaseInstanceWithPublishedLogs}}
+
+data "vkcs_db_instance_log" "slow_query" {
+	instance_id = vkcs_db_instance.with_logs.id
+	name = "slow_query"
+	lines = 10
+}
+`
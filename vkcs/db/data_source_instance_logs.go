@@ -0,0 +1,162 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/clients"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/db/v1/instances"
+)
+
+var (
+	_ datasource.DataSource              = &InstanceLogsDataSource{}
+	_ datasource.DataSourceWithConfigure = &InstanceLogsDataSource{}
+)
+
+func NewInstanceLogsDataSource() datasource.DataSource {
+	return &InstanceLogsDataSource{}
+}
+
+type InstanceLogsDataSource struct {
+	config clients.Config
+}
+
+type InstanceLogsDataSourceModel struct {
+	ID     types.String `tfsdk:"id"`
+	Region types.String `tfsdk:"region"`
+
+	InstanceID types.String       `tfsdk:"instance_id"`
+	Logs       []InstanceLogModel `tfsdk:"logs"`
+}
+
+type InstanceLogModel struct {
+	Name      types.String `tfsdk:"name"`
+	Type      types.String `tfsdk:"type"`
+	Status    types.String `tfsdk:"status"`
+	Published types.Int64  `tfsdk:"published"`
+	Pending   types.Int64  `tfsdk:"pending"`
+}
+
+func (d *InstanceLogsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "vkcs_db_instance_logs"
+}
+
+func (d *InstanceLogsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the resource.",
+			},
+
+			"region": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The region to obtain the service client. If omitted, the `region` argument of the provider is used.",
+			},
+
+			"instance_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the instance.",
+			},
+
+			"logs": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the log, e.g. `general`, `slow_query` or `error`.",
+						},
+
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Type of the log. `USER` logs can be enabled and disabled, `SYS` logs are always collected.",
+						},
+
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "Publish status of the log, e.g. `Disabled`, `Enabled`, `Published`.",
+						},
+
+						"published": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Size of the published part of the log in bytes.",
+						},
+
+						"pending": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Size of the part of the log which is not published yet in bytes.",
+						},
+					},
+				},
+				Description: "Logs available for the instance.",
+			},
+		},
+		Description: "Use this data source to get logs available for a VKCS db instance and their publish status.",
+	}
+}
+
+func (d *InstanceLogsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.config = req.ProviderData.(clients.Config)
+}
+
+func (d *InstanceLogsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data InstanceLogsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	region := data.Region.ValueString()
+	if region == "" {
+		region = d.config.GetRegion()
+	}
+
+	client, err := d.config.DatabaseV1Client(region)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating VKCS Databases API client", err.Error())
+		return
+	}
+
+	instanceID := data.InstanceID.ValueString()
+	ctx = tflog.SetField(ctx, "instance_id", instanceID)
+
+	tflog.Debug(ctx, "Calling Databases API to list logs of the instance")
+
+	logs, err := instances.ListLogs(client, instanceID).Extract()
+	if err != nil {
+		resp.Diagnostics.AddError("Error calling VKCS Databases API", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Called Databases API to list logs of the instance", map[string]interface{}{"logs": fmt.Sprintf("%#v", logs)})
+
+	data.ID = types.StringValue(fmt.Sprintf("%s/logs", instanceID))
+	data.Region = types.StringValue(region)
+	data.Logs = flattenInstanceLogs(logs)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func flattenInstanceLogs(logs []instances.Log) (r []InstanceLogModel) {
+	for _, l := range logs {
+		r = append(r, InstanceLogModel{
+			Name:      types.StringValue(l.Name),
+			Type:      types.StringValue(l.Type),
+			Status:    types.StringValue(l.Status),
+			Published: types.Int64Value(int64(l.Published)),
+			Pending:   types.Int64Value(int64(l.Pending)),
+		})
+	}
+	return
+}
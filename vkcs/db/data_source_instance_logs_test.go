@@ -0,0 +1,59 @@
+package db_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/acctest"
+)
+
+func TestAccDatabaseInstanceLogsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.AccTestProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDatabaseInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.AccTestRenderConfig(testAccDatabaseInstanceLogsDataSourceBasic, map[string]string{"TestAccDatabaseInstanceWithPublishedLogs": acctest.AccTestRenderConfig(testAccDatabaseInstanceWithPublishedLogs)}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.vkcs_db_instance_logs.logs", "instance_id", "vkcs_db_instance.with_logs", "id"),
+					resource.TestCheckResourceAttrSet("data.vkcs_db_instance_logs.logs", "logs.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDatabaseInstanceWithPublishedLogs = `
+{{.BaseNetwork}}
+{{.BaseFlavor}}
+
+resource "vkcs_db_instance" "with_logs" {
+  name             = "with-logs"
+  flavor_id = data.vkcs_compute_flavor.base.id
+  size = 8
+  volume_type = "{{.VolumeType}}"
+
+  datastore {
+    version = "8.0"
+    type    = "mysql"
+  }
+
+  network {
+    uuid = vkcs_networking_network.base.id
+  }
+  availability_zone = "{{.AvailabilityZone}}"
+
+  published_logs = ["slow_query"]
+
+  depends_on = [vkcs_networking_router_interface.base]
+}
+`
+
+const testAccDatabaseInstanceLogsDataSourceBasic = `
+{{.TestAccDatabaseInstanceWithPublishedLogs}}
+
+data "vkcs_db_instance_logs" "logs" {
+	instance_id = vkcs_db_instance.with_logs.id
+}
+`
@@ -2,6 +2,7 @@ package db
 
 import (
 	"fmt"
+	"log"
 	"strings"
 
 	"github.com/gophercloud/gophercloud"
@@ -208,6 +209,69 @@ func flattenDatabaseBackupSchedule(b instances.BackupSchedule) []map[string]inte
 	return backupschedule
 }
 
+func extractDatabaseMaintenanceWindow(v []interface{}) (instances.MaintenanceWindow, error) {
+	var M instances.MaintenanceWindow
+	in := v[0].(map[string]interface{})
+	err := util.MapStructureDecoder(&M, &in, util.DecoderConfig)
+	if err != nil {
+		return M, err
+	}
+	return M, nil
+}
+
+func flattenDatabaseMaintenanceWindow(m instances.MaintenanceWindow) []map[string]interface{} {
+	maintenanceWindow := make([]map[string]interface{}, 1)
+	maintenanceWindow[0] = make(map[string]interface{})
+	maintenanceWindow[0]["day"] = m.Day
+	maintenanceWindow[0]["start_hour"] = m.StartHour
+	maintenanceWindow[0]["duration"] = m.Duration
+
+	return maintenanceWindow
+}
+
+// Statuses of a log that is not collected by the instance
+var dbInstanceLogInactiveStatuses = []string{"Disabled", "Unavailable"}
+
+// databaseInstancePublishLogs enables logs of the instance and publishes
+// them to make their content available.
+func databaseInstancePublishLogs(client *gophercloud.ServiceClient, instanceID string, names []string) error {
+	for _, name := range names {
+		_, err := instances.LogAction(client, instanceID, &instances.LogActionOpts{Name: name, Enable: 1}).Extract()
+		if err != nil {
+			return fmt.Errorf("error enabling log %s: %s", name, err)
+		}
+		_, err = instances.LogAction(client, instanceID, &instances.LogActionOpts{Name: name, Publish: 1}).Extract()
+		if err != nil {
+			return fmt.Errorf("error publishing log %s: %s", name, err)
+		}
+		log.Printf("[DEBUG] Published log %s of instance %s", name, instanceID)
+	}
+	return nil
+}
+
+func databaseInstanceDisableLogs(client *gophercloud.ServiceClient, instanceID string, names []string) error {
+	for _, name := range names {
+		_, err := instances.LogAction(client, instanceID, &instances.LogActionOpts{Name: name, Disable: 1}).Extract()
+		if err != nil {
+			return fmt.Errorf("error disabling log %s: %s", name, err)
+		}
+		log.Printf("[DEBUG] Disabled log %s of instance %s", name, instanceID)
+	}
+	return nil
+}
+
+// getDatabaseInstancePublishedLogs returns names of managed logs which are
+// still collected by the instance.
+func getDatabaseInstancePublishedLogs(logs []instances.Log, managed []string) []string {
+	published := make([]string, 0, len(managed))
+	for _, l := range logs {
+		if util.StrSliceContains(managed, l.Name) && !util.StrSliceContains(dbInstanceLogInactiveStatuses, l.Status) {
+			published = append(published, l.Name)
+		}
+	}
+	return published
+}
+
 func databaseInstanceStateRefreshFunc(client *gophercloud.ServiceClient, instanceID string, capabilitiesOpts *[]instances.CapabilityOpts) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		i, err := instances.Get(client, instanceID).Extract()
@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -33,6 +34,7 @@ var (
 	clusterStatusResize             clusterStatus = "RESIZING_CLUSTER"
 	clusterStatusShrink             clusterStatus = "SHRINKING_CLUSTER"
 	clusterStatusUpdating           clusterStatus = "UPDATING_CLUSTER"
+	clusterStatusUpgrade            clusterStatus = "UPGRADING_CLUSTER"
 )
 
 const (
@@ -45,7 +47,10 @@ func ResourceDatabaseCluster() *schema.Resource {
 		ReadContext:   resourceDatabaseClusterRead,
 		DeleteContext: resourceDatabaseClusterDelete,
 		UpdateContext: resourceDatabaseClusterUpdate,
-		CustomizeDiff: resourceDatabaseCustomizeDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceDatabaseCustomizeDiff,
+			resourceDatabaseDatastoreUpgradeCustomizeDiff,
+		),
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				config := meta.(clients.Config)
@@ -151,15 +156,15 @@ func ResourceDatabaseCluster() *schema.Resource {
 			"datastore": {
 				Type:     schema.TypeList,
 				Required: true,
-				ForceNew: true,
+				ForceNew: false,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"version": {
 							Type:        schema.TypeString,
 							Required:    true,
-							ForceNew:    true,
-							Description: "Version of the datastore. Changing this creates a new cluster.",
+							ForceNew:    false,
+							Description: "Version of the datastore. Changing this upgrades the cluster in place. Only upgrades to newer versions are allowed.",
 						},
 						"type": {
 							Type:     schema.TypeString,
@@ -177,7 +182,7 @@ func ResourceDatabaseCluster() *schema.Resource {
 						},
 					},
 				},
-				Description: "Object that represents datastore of the cluster. Changing this creates a new cluster.",
+				Description: "Object that represents datastore of the cluster. Changing type of the datastore creates a new cluster.",
 			},
 
 			"loadbalancer_id": {
@@ -427,6 +432,36 @@ func ResourceDatabaseCluster() *schema.Resource {
 				},
 			},
 
+			"maintenance_window": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"day": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(databaseMaintenanceWindowDays, false),
+							Description:  "Day of the week to start maintenance. Must be one of: `mon`, `tue`, `wed`, `thu`, `fri`, `sat`, `sun`.",
+						},
+						"start_hour": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 23),
+							Description:  "Hour of the day (UTC) to start maintenance.",
+						},
+						"duration": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 24),
+							Description:  "Duration of maintenance in hours.",
+						},
+					},
+				},
+				Description: "Object that represents weekly window in which maintenance of the cluster, e.g. restarts and minor updates, is performed. If omitted, the window is chosen by the service.",
+			},
+
 			"cloud_monitoring_enabled": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -451,6 +486,18 @@ func ResourceDatabaseCluster() *schema.Resource {
 				Description: "Map of additional vendor-specific options. Supported options are described below.",
 			},
 
+			"restart_trigger": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Any string. Changing this value to a non-empty one performs a rolling restart of the cluster instances, e.g. to apply configuration group changes. Instances are restarted one by one, the leader is restarted last.",
+			},
+
+			"switchover_trigger": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Any string. Changing this value to a non-empty one performs a planned switchover of the leader role to another instance of the cluster. New roles are reported in `instances`.",
+			},
+
 			// Computed values
 			"instances": {
 				Type:     schema.TypeList,
@@ -592,6 +639,14 @@ func resourceDatabaseClusterCreate(ctx context.Context, d *schema.ResourceData,
 		createOpts.BackupSchedule = &backupSchedule
 	}
 
+	if v, ok := d.GetOk("maintenance_window"); ok {
+		maintenanceWindow, err := extractDatabaseMaintenanceWindow(v.([]interface{}))
+		if err != nil {
+			return diag.Errorf("%s maintenance_window", message)
+		}
+		createOpts.MaintenanceWindow = &maintenanceWindow
+	}
+
 	var checkCapabilities *[]instances.CapabilityOpts
 	if capabilities, ok := d.GetOk("capabilities"); ok {
 		capabilitiesOpts, err := extractDatabaseCapabilities(capabilities.([]interface{}))
@@ -745,6 +800,12 @@ func resourceDatabaseClusterRead(ctx context.Context, d *schema.ResourceData, me
 		d.Set("backup_schedule", nil)
 	}
 
+	if cluster.MaintenanceWindow != nil {
+		d.Set("maintenance_window", flattenDatabaseMaintenanceWindow(*cluster.MaintenanceWindow))
+	} else {
+		d.Set("maintenance_window", nil)
+	}
+
 	if !d.HasChangesExcept() {
 		return nil
 	}
@@ -779,6 +840,13 @@ func resourceDatabaseClusterUpdate(ctx context.Context, d *schema.ResourceData,
 		StateConf: stateConf,
 	}
 
+	if d.HasChange("datastore.0.version") {
+		err = databaseClusterActionUpgrade(updateCtx)
+		if err != nil {
+			return databaseClusterUpdateProcessError(err, clusterID)
+		}
+	}
+
 	if d.HasChange("configuration_id") {
 		err = databaseClusterActionUpdateConfiguration(updateCtx)
 		if err != nil {
@@ -869,6 +937,27 @@ func resourceDatabaseClusterUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
+	if d.HasChange("maintenance_window") {
+		err = databaseClusterUpdateMaintenanceWindow(updateCtx)
+		if err != nil {
+			return databaseClusterUpdateProcessError(err, clusterID)
+		}
+	}
+
+	if d.HasChange("restart_trigger") && d.Get("restart_trigger").(string) != "" {
+		err = databaseClusterActionRestart(updateCtx)
+		if err != nil {
+			return databaseClusterUpdateProcessError(err, clusterID)
+		}
+	}
+
+	if d.HasChange("switchover_trigger") && d.Get("switchover_trigger").(string) != "" {
+		err = databaseClusterActionSwitchover(updateCtx)
+		if err != nil {
+			return databaseClusterUpdateProcessError(err, clusterID)
+		}
+	}
+
 	diags := make(diag.Diagnostics, 0)
 
 	if d.HasChange("root_enabled") {
@@ -941,6 +1030,10 @@ func databaseClusterUpdateProcessError(err error, clusterID string) diag.Diagnos
 		newErrMsg = fmt.Sprintf("unable to determine wal_disk_autoexpand from vkcs_db_cluster %s", clusterID)
 	case errDBClusterUpdateCloudMonitoring:
 		newErrMsg = fmt.Sprintf("error updating cloud_monitoring_enabled for vkcs_db_cluster %s", clusterID)
+	case errDBClusterUpdateMaintenanceWindow:
+		newErrMsg = fmt.Sprintf("error updating maintenance_window for vkcs_db_cluster %s", clusterID)
+	case errDBClusterUpdateMaintenanceWindowExtract:
+		newErrMsg = fmt.Sprintf("unable to determine maintenance_window from vkcs_db_cluster %s", clusterID)
 
 	case errDBClusterActionUpdateConfiguration:
 		newErrMsg = fmt.Sprintf("error updating configuration for vkcs_db_cluster %s", clusterID)
@@ -958,6 +1051,12 @@ func databaseClusterUpdateProcessError(err error, clusterID string) diag.Diagnos
 		newErrMsg = fmt.Sprintf("invalid shrink options for vkcs_db_cluster %s", clusterID)
 	case errDBClusterActionShrinkInstancesExtract:
 		newErrMsg = fmt.Sprintf("error determining instances to shrink vkcs_db_cluster %s", clusterID)
+	case errDBClusterActionRestart:
+		newErrMsg = fmt.Sprintf("error restarting instances of vkcs_db_cluster %s", clusterID)
+	case errDBClusterActionSwitchover:
+		newErrMsg = fmt.Sprintf("error switching over vkcs_db_cluster %s", clusterID)
+	case errDBClusterActionUpgrade:
+		newErrMsg = fmt.Sprintf("error upgrading datastore version of vkcs_db_cluster %s", clusterID)
 	case errDBClusterActionResizeVolume:
 		newErrMsg = fmt.Sprintf("error resizing volume for vkcs_db_cluster %s", clusterID)
 	case errDBClusterActionResizeWalVolume:
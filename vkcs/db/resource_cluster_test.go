@@ -146,6 +146,45 @@ func TestAccDatabaseCluster_shrink_big(t *testing.T) {
 	})
 }
 
+func TestAccDatabaseCluster_operations_big(t *testing.T) {
+	var cluster clusters.ClusterResp
+	var leaderID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.AccTestPreCheck(t) },
+		ProviderFactories: acctest.AccTestProviders,
+		CheckDestroy:      testAccCheckDatabaseClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.AccTestRenderConfig(testAccDatabaseClusterOperations, map[string]string{"RestartTrigger": "", "SwitchoverTrigger": ""}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatabaseClusterExists(
+						"vkcs_db_cluster.basic", &cluster),
+					testAccCheckDatabaseClusterLeader(&cluster, &leaderID),
+				),
+			},
+			{
+				Config: acctest.AccTestRenderConfig(testAccDatabaseClusterOperations, map[string]string{"RestartTrigger": "1", "SwitchoverTrigger": ""}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatabaseClusterExists(
+						"vkcs_db_cluster.basic", &cluster),
+					testAccCheckDatabaseClusterLeaderExists(&cluster),
+					resource.TestCheckResourceAttr(
+						"vkcs_db_cluster.basic", "restart_trigger", "1"),
+				),
+			},
+			{
+				Config: acctest.AccTestRenderConfig(testAccDatabaseClusterOperations, map[string]string{"RestartTrigger": "1", "SwitchoverTrigger": "1"}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatabaseClusterExists(
+						"vkcs_db_cluster.basic", &cluster),
+					testAccCheckDatabaseClusterLeaderChanged(&cluster, &leaderID),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckDatabaseClusterExists(n string, cluster *clusters.ClusterResp) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -210,6 +249,32 @@ func testAccCheckDatabaseClusterLeaderExists(cluster *clusters.ClusterResp) reso
 	}
 }
 
+func testAccCheckDatabaseClusterLeader(cluster *clusters.ClusterResp, leaderID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, inst := range cluster.Instances {
+			if inst.Role == db.ClusterInstanceRoleLeader {
+				*leaderID = inst.ID
+				return nil
+			}
+		}
+		return fmt.Errorf("cluster leader instance is absent")
+	}
+}
+
+func testAccCheckDatabaseClusterLeaderChanged(cluster *clusters.ClusterResp, leaderID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, inst := range cluster.Instances {
+			if inst.Role == db.ClusterInstanceRoleLeader {
+				if inst.ID == *leaderID {
+					return fmt.Errorf("cluster leader instance %s has not changed after switchover", inst.ID)
+				}
+				return nil
+			}
+		}
+		return fmt.Errorf("cluster leader instance is absent")
+	}
+}
+
 const testAccDatabaseClusterBasic = `
 {{.BaseNetwork}}
 {{.BaseFlavor}}
@@ -290,6 +355,32 @@ resource "vkcs_db_cluster" "basic" {
 }
 `
 
+const testAccDatabaseClusterOperations = `
+{{.BaseNetwork}}
+{{.BaseFlavor}}
+
+resource "vkcs_db_cluster" "basic" {
+  name         = "basic"
+  flavor_id    = data.vkcs_compute_flavor.base.id
+  volume_size  = 8
+  volume_type  = "{{.VolumeType}}"
+  cluster_size = 3
+  datastore {
+    version = "13"
+    type    = "postgresql"
+  }
+  network {
+    uuid = vkcs_networking_network.base.id
+  }
+
+  availability_zone  = "{{.AvailabilityZone}}"
+  restart_trigger    = "{{.RestartTrigger}}"
+  switchover_trigger = "{{.SwitchoverTrigger}}"
+
+  depends_on = [vkcs_networking_router_interface.base]
+}
+`
+
 const testAccDatabaseClusterWal = `
 {{.BaseNetwork}}	
 {{.BaseFlavor}}
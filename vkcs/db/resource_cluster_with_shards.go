@@ -25,7 +25,7 @@ func ResourceDatabaseClusterWithShards() *schema.Resource {
 		ReadContext:   resourceDatabaseClusterWithShardsRead,
 		DeleteContext: resourceDatabaseClusterWithShardsDelete,
 		UpdateContext: resourceDatabaseClusterWithShardsUpdate,
-		CustomizeDiff: resourceDatabaseCustomizeDiff,
+		CustomizeDiff: resourceDatabaseClusterWithShardsCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				config := meta.(clients.Config)
@@ -265,8 +265,8 @@ func ResourceDatabaseClusterWithShards() *schema.Resource {
 						"shard_id": {
 							Type:        schema.TypeString,
 							Required:    true,
-							ForceNew:    true,
-							Description: "The ID of the shard. Changing this creates a new cluster.",
+							ForceNew:    false,
+							Description: "The ID of the shard. Shards are matched by this ID, so shards can be reordered, added and removed without recreating the cluster.",
 						},
 
 						"size": {
@@ -335,38 +335,38 @@ func ResourceDatabaseClusterWithShards() *schema.Resource {
 						"network": {
 							Type:     schema.TypeList,
 							Optional: true,
-							ForceNew: true,
+							ForceNew: false,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"uuid": {
 										Type:        schema.TypeString,
 										Optional:    true,
-										ForceNew:    true,
-										Description: "The id of the network. Changing this creates a new cluster. _note_ Although this argument is marked as optional, it is actually required at the moment. Not setting a value for it may cause an error.",
+										ForceNew:    false,
+										Description: "The id of the network. Changing this for an existing shard creates a new cluster. _note_ Although this argument is marked as optional, it is actually required at the moment. Not setting a value for it may cause an error.",
 									},
 									"port": {
 										Type:        schema.TypeString,
 										Optional:    true,
-										ForceNew:    true,
-										Description: "The port id of the network. Changing this creates a new cluster.",
+										ForceNew:    false,
+										Description: "The port id of the network. Changing this for an existing shard creates a new cluster.",
 										Deprecated:  "This argument is deprecated, please do not use it.",
 									},
 									"subnet_id": {
 										Type:        schema.TypeString,
 										Optional:    true,
-										ForceNew:    true,
-										Description: "The id of the subnet. Changing this creates a new cluster.",
+										ForceNew:    false,
+										Description: "The id of the subnet. Changing this for an existing shard creates a new cluster.",
 									},
 									"security_groups": {
 										Type:        schema.TypeSet,
 										Optional:    true,
-										ForceNew:    true,
+										ForceNew:    false,
 										Elem:        &schema.Schema{Type: schema.TypeString},
 										Set:         schema.HashString,
-										Description: "An array of one or more security group IDs to associate with the shard instances. Changing this creates a new cluster.",
+										Description: "An array of one or more security group IDs to associate with the shard instances. Changing this for an existing shard creates a new cluster.",
 									},
 								},
-								Description: "Object that represents network of the cluster shard. Changing this creates a new cluster.",
+								Description: "Object that represents network of the cluster shard. Changing this for an existing shard creates a new cluster.",
 							},
 						},
 
@@ -374,8 +374,8 @@ func ResourceDatabaseClusterWithShards() *schema.Resource {
 							Type:        schema.TypeString,
 							Optional:    true,
 							Computed:    false,
-							ForceNew:    true,
-							Description: "The name of the availability zone of the cluster shard. Changing this creates a new cluster.",
+							ForceNew:    false,
+							Description: "The name of the availability zone of the cluster shard. Changing this for an existing shard creates a new cluster.",
 						},
 
 						"instances": {
@@ -658,16 +658,19 @@ func resourceDatabaseClusterWithShardsRead(ctx context.Context, d *schema.Resour
 	shards := make([]map[string]interface{}, 0, len(flattenedShards))
 	newShards := make([]map[string]interface{}, 0, len(flattenedShards))
 
-OuterLoop:
-	for _, fSh := range flattenedShards {
-		for _, rawSh := range rawShards {
-			rawShMap := rawSh.(map[string]interface{})
+	for _, rawSh := range rawShards {
+		rawShMap := rawSh.(map[string]interface{})
+		for _, fSh := range flattenedShards {
 			if fSh["shard_id"].(string) == rawShMap["shard_id"].(string) {
 				shards = append(shards, fSh)
-				continue OuterLoop
+				break
 			}
 		}
-		newShards = append(newShards, fSh)
+	}
+	for _, fSh := range flattenedShards {
+		if findDatabaseClusterShard(rawShards, fSh["shard_id"].(string)) == nil {
+			newShards = append(newShards, fSh)
+		}
 	}
 
 	shards = append(shards, newShards...)
@@ -764,35 +767,45 @@ func resourceDatabaseClusterWithShardsUpdate(ctx context.Context, d *schema.Reso
 		}
 	}
 
-	shardsRaw := d.Get("shard").([]interface{})
-	for i, shardRaw := range shardsRaw {
-		shard := shardRaw.(map[string]interface{})
-		shardID := shard["shard_id"].(string)
-		pathPrefix := fmt.Sprintf("shard.%d.", i)
+	oldShardsRaw, newShardsRaw := d.GetChange("shard")
+	addedShards, removedShards := databaseClusterShardsDiff(oldShardsRaw.([]interface{}), newShardsRaw.([]interface{}))
+
+	for _, shardID := range addedShards {
+		err = databaseClusterActionGrow(updateCtx, shardID)
+		if err != nil {
+			return databaseClusterWithShardsUpdateProcessError(err, clusterID, shardID)
+		}
+	}
+
+	for _, shardRaw := range newShardsRaw.([]interface{}) {
+		shardID := shardRaw.(map[string]interface{})["shard_id"].(string)
+		if util.StrSliceContains(addedShards, shardID) {
+			continue
+		}
 
-		if p := pathPrefix + "volume_size"; d.HasChange(p) {
+		if databaseClusterShardHasChange(d, shardID, "volume_size") {
 			err = databaseClusterActionResizeVolume(updateCtx, shardID)
 			if err != nil {
 				return databaseClusterWithShardsUpdateProcessError(err, clusterID, shardID)
 			}
 		}
 
-		if p := pathPrefix + "wal_volume"; d.HasChange(p) {
+		if databaseClusterShardHasChange(d, shardID, "wal_volume") {
 			err = databaseClusterActionResizeWalVolume(updateCtx, shardID)
 			if err != nil {
 				return databaseClusterWithShardsUpdateProcessError(err, clusterID, shardID)
 			}
 		}
 
-		if p := pathPrefix + "flavor_id"; d.HasChange(p) {
+		if databaseClusterShardHasChange(d, shardID, "flavor_id") {
 			err = databaseClusterActionResizeFlavor(updateCtx, shardID)
 			if err != nil {
 				return databaseClusterWithShardsUpdateProcessError(err, clusterID, shardID)
 			}
 		}
 
-		if p := pathPrefix + "size"; d.HasChange(p) {
-			old, new := d.GetChange(p)
+		if databaseClusterShardHasChange(d, shardID, "size") {
+			old, new := databaseClusterShardChange(d, shardID, "size")
 			if sizeChange := new.(int) - old.(int); sizeChange > 0 {
 				err = databaseClusterActionGrow(updateCtx, shardID)
 			} else if sizeChange < 0 {
@@ -804,6 +817,13 @@ func resourceDatabaseClusterWithShardsUpdate(ctx context.Context, d *schema.Reso
 		}
 	}
 
+	for _, shardID := range removedShards {
+		err = databaseClusterActionRemoveShard(updateCtx, shardID)
+		if err != nil {
+			return databaseClusterWithShardsUpdateProcessError(err, clusterID, shardID)
+		}
+	}
+
 	diags := make(diag.Diagnostics, 0)
 
 	if d.HasChange("root_enabled") {
@@ -853,6 +873,73 @@ func resourceDatabaseClusterWithShardsDelete(ctx context.Context, d *schema.Reso
 	return nil
 }
 
+func resourceDatabaseClusterWithShardsCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta any) error {
+	err := resourceDatabaseCustomizeDiff(ctx, diff, meta)
+	if err != nil {
+		return err
+	}
+
+	if err := checkDatabaseClusterShardIDs(diff.Get("shard").([]interface{})); err != nil {
+		return err
+	}
+
+	if diff.Id() == "" || !diff.HasChange("shard") {
+		return nil
+	}
+
+	// Shards are added and removed in place, but network and availability
+	// zone of an existing shard can not be changed
+	o, n := diff.GetChange("shard")
+	oldShardsRaw := o.([]interface{})
+	for i, shRaw := range n.([]interface{}) {
+		newShard := shRaw.(map[string]interface{})
+		shardID := newShard["shard_id"].(string)
+		oldShard := findDatabaseClusterShard(oldShardsRaw, shardID)
+		if oldShard == nil {
+			continue
+		}
+		var changedKeys []string
+		if oldShard["availability_zone"] != newShard["availability_zone"] {
+			changedKeys = append(changedKeys, fmt.Sprintf("shard.%d.availability_zone", i))
+		}
+		if !databaseClusterShardNetworksEqual(oldShard["network"].([]interface{}), newShard["network"].([]interface{})) {
+			changedKeys = append(changedKeys, diff.GetChangedKeysPrefix(fmt.Sprintf("shard.%d.network", i))...)
+		}
+		if len(changedKeys) == 0 {
+			continue
+		}
+		if err := databaseClusterShardForceNew(diff, shardID, changedKeys); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// databaseClusterShardForceNew marks changed keys of an existing shard as
+// requiring a new cluster. Shards are matched by shard_id, so the list index
+// of a moved shard may show no change even though the shard itself changed.
+func databaseClusterShardForceNew(diff *schema.ResourceDiff, shardID string, keys []string) error {
+	forced := false
+	for _, k := range keys {
+		// Count of a list or set has no schema of its own, ForceNew is set
+		// on the collection instead
+		k = strings.TrimSuffix(k, ".#")
+		if !diff.HasChange(k) {
+			continue
+		}
+		if err := diff.ForceNew(k); err != nil {
+			return err
+		}
+		forced = true
+	}
+	if !forced {
+		return fmt.Errorf("changing network or availability_zone of shard %s requires a new cluster, "+
+			"keep the order of shards unchanged to plan the replacement", shardID)
+	}
+	return nil
+}
+
 func databaseClusterWithShardsUpdateProcessError(err error, clusterID string, shardID string) diag.Diagnostics {
 	baseErr := err
 	if unwrappedErr := errors.Unwrap(err); unwrappedErr != nil {
@@ -895,6 +982,8 @@ func databaseClusterWithShardsUpdateProcessError(err error, clusterID string, sh
 		newErrMsg = fmt.Sprintf("invalid shrink options for shard %s of vkcs_db_cluster_with_shards %s", shardID, clusterID)
 	case errDBClusterActionShrinkInstancesExtract:
 		newErrMsg = fmt.Sprintf("error determining instances to shrink shard %s of vkcs_db_cluster_with_shards %s", shardID, clusterID)
+	case errDBClusterActionRemoveShard:
+		newErrMsg = fmt.Sprintf("error removing shard %s of vkcs_db_cluster_with_shards %s", shardID, clusterID)
 	case errDBClusterActionResizeVolume:
 		newErrMsg = fmt.Sprintf("error resizing volume for shard %s of vkcs_db_cluster_with_shards %s", shardID, clusterID)
 	case errDBClusterActionResizeWalVolume:
@@ -142,6 +142,56 @@ func TestAccDatabaseClusterWithShards_resize_big(t *testing.T) {
 	})
 }
 
+func TestAccDatabaseClusterWithShards_shards_big(t *testing.T) {
+	var cluster, updatedCluster clusters.ClusterResp
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.AccTestPreCheck(t) },
+		ProviderFactories: acctest.AccTestProviders,
+		CheckDestroy:      testAccCheckDatabaseClusterWithShardsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.AccTestRenderConfig(testAccDatabaseClusterWithShardsShards, map[string]string{"Shards": acctest.AccTestRenderConfig(testAccDatabaseClusterWithShardsShard0)}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatabaseClusterExists("vkcs_db_cluster_with_shards.shards", &cluster),
+					resource.TestCheckResourceAttr("vkcs_db_cluster_with_shards.shards", "shard.#", "1"),
+					resource.TestCheckResourceAttr("vkcs_db_cluster_with_shards.shards", "shard.0.shard_id", "shard0"),
+				),
+			},
+			{
+				Config: acctest.AccTestRenderConfig(testAccDatabaseClusterWithShardsShards, map[string]string{"Shards": acctest.AccTestRenderConfig(testAccDatabaseClusterWithShardsShard1 + testAccDatabaseClusterWithShardsShard0)}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatabaseClusterExists("vkcs_db_cluster_with_shards.shards", &updatedCluster),
+					testAccCheckDatabaseClusterNotRecreated(&cluster, &updatedCluster),
+					resource.TestCheckResourceAttr("vkcs_db_cluster_with_shards.shards", "shard.#", "2"),
+					resource.TestCheckResourceAttr("vkcs_db_cluster_with_shards.shards", "shard.0.shard_id", "shard1"),
+					resource.TestCheckResourceAttr("vkcs_db_cluster_with_shards.shards", "shard.0.instances.#", "1"),
+					resource.TestCheckResourceAttr("vkcs_db_cluster_with_shards.shards", "shard.1.shard_id", "shard0"),
+					resource.TestCheckResourceAttr("vkcs_db_cluster_with_shards.shards", "shard.1.instances.#", "1"),
+				),
+			},
+			{
+				Config: acctest.AccTestRenderConfig(testAccDatabaseClusterWithShardsShards, map[string]string{"Shards": acctest.AccTestRenderConfig(testAccDatabaseClusterWithShardsShard1)}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatabaseClusterExists("vkcs_db_cluster_with_shards.shards", &updatedCluster),
+					testAccCheckDatabaseClusterNotRecreated(&cluster, &updatedCluster),
+					resource.TestCheckResourceAttr("vkcs_db_cluster_with_shards.shards", "shard.#", "1"),
+					resource.TestCheckResourceAttr("vkcs_db_cluster_with_shards.shards", "shard.0.shard_id", "shard1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDatabaseClusterNotRecreated(before, after *clusters.ClusterResp) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before.ID != after.ID {
+			return fmt.Errorf("cluster was recreated: %s != %s", before.ID, after.ID)
+		}
+		return nil
+	}
+}
+
 func testAccCheckDatabaseClusterWithShardsDestroy(s *terraform.State) error {
 	config := acctest.AccTestProvider.Meta().(clients.Config)
 
@@ -387,3 +437,47 @@ resource "vkcs_db_cluster_with_shards" "resize" {
   depends_on = [vkcs_networking_router_interface.base]
 }
 `
+
+const testAccDatabaseClusterWithShardsShards = `
+{{.BaseNetwork}}
+{{.BaseFlavor}}
+
+resource "vkcs_db_cluster_with_shards" "shards" {
+  name = "shards"
+
+  datastore {
+    version = "24.3"
+    type    = "clickhouse"
+  }
+{{.Shards}}
+  depends_on = [vkcs_networking_router_interface.base]
+}
+`
+
+const testAccDatabaseClusterWithShardsShard0 = `
+  shard {
+    size        = 1
+    shard_id    = "shard0"
+    flavor_id   = data.vkcs_compute_flavor.base.id
+    volume_size = 8
+    volume_type = "ceph-ssd"
+    network {
+      uuid = vkcs_networking_network.base.id
+    }
+    availability_zone = "{{.AvailabilityZone}}"
+  }
+`
+
+const testAccDatabaseClusterWithShardsShard1 = `
+  shard {
+    size        = 1
+    shard_id    = "shard1"
+    flavor_id   = data.vkcs_compute_flavor.base.id
+    volume_size = 8
+    volume_type = "ceph-ssd"
+    network {
+      uuid = vkcs_networking_network.base.id
+    }
+    availability_zone = "{{.AvailabilityZone}}"
+  }
+`
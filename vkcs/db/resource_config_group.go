@@ -2,8 +2,10 @@ package db
 
 import (
 	"context"
+	"fmt"
 	"log"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/clients"
@@ -22,6 +24,8 @@ func ResourceDatabaseConfigGroup() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceDatabaseConfigGroupCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"datastore": {
 				Type:     schema.TypeList,
@@ -63,7 +67,7 @@ func ResourceDatabaseConfigGroup() *schema.Resource {
 				Required:    true,
 				ForceNew:    false,
 				Elem:        &schema.Schema{Type: schema.TypeString},
-				Description: "Map of configuration parameters in format \"key\": \"value\".",
+				Description: "Map of configuration parameters in format \"key\": \"value\". Parameters are validated against parameters of the datastore at plan time, see `vkcs_db_datastore_parameters` data source.",
 			},
 			"updated": {
 				Type:        schema.TypeString,
@@ -143,7 +147,15 @@ func resourceDatabaseConfigGroupRead(ctx context.Context, d *schema.ResourceData
 		Version: configGroup.DatastoreVersionName,
 	}
 	d.Set("datastore", flattenDatabaseInstanceDatastore(ds))
-	d.Set("values", flattenDatabaseConfigGroupValues(configGroup.Values))
+
+	values := flattenDatabaseConfigGroupValues(configGroup.Values)
+	dsParameters, err := datastores.ListParameters(DatabaseV1Client, ds.Type, ds.Version).Extract()
+	if err != nil {
+		log.Printf("[WARN] Unable to retrieve parameters of vkcs_db_config_group %s datastore: %s", d.Id(), err)
+	} else {
+		values = normalizeDatabaseConfigGroupValues(values, d.Get("values").(map[string]interface{}), getDSParameterTypesMap(dsParameters))
+	}
+	d.Set("values", values)
 
 	d.Set("updated", configGroup.Updated)
 	d.Set("created", configGroup.Created)
@@ -165,8 +177,13 @@ func resourceDatabaseConfigGroupUpdate(ctx context.Context, d *schema.ResourceDa
 		return diag.Errorf("unable to determine vkcs_db_config_group datastore")
 	}
 
+	dsParameters, err := datastores.ListParameters(DatabaseV1Client, datastore.Type, datastore.Version).Extract()
+	if err != nil {
+		return diag.Errorf("unable to determine vkcs_db_config_group parameter types")
+	}
+
 	v = d.Get("values")
-	values, err := retrieveDatabaseConfigGroupValues(DatabaseV1Client, datastore, v.(map[string]interface{}))
+	values, err := extractDatabaseConfigGroupValues(v.(map[string]interface{}), getDSParameterTypesMap(dsParameters))
 	if err != nil {
 		return diag.Errorf("unable to determine vkcs_db_config_group values: %s", err)
 	}
@@ -186,7 +203,49 @@ func resourceDatabaseConfigGroupUpdate(ctx context.Context, d *schema.ResourceDa
 	if err != nil {
 		return diag.Errorf("error updating vkcs_db_config_group: %s", err)
 	}
-	return resourceDatabaseConfigGroupRead(ctx, d, meta)
+
+	var diags diag.Diagnostics
+	o, n := d.GetChange("values")
+	restartRequired := getDatabaseConfigGroupRestartRequired(o.(map[string]interface{}), n.(map[string]interface{}), dsParameters)
+	if len(restartRequired) > 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       "Restart of attached instances is required",
+			Detail:        databaseConfigGroupRestartRequiredMessage(restartRequired),
+			AttributePath: cty.GetAttrPath("values"),
+		})
+	}
+
+	return append(diags, resourceDatabaseConfigGroupRead(ctx, d, meta)...)
+}
+
+func resourceDatabaseConfigGroupCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChange("values") || !diff.NewValueKnown("values") || !diff.NewValueKnown("datastore") {
+		return nil
+	}
+
+	config := meta.(clients.Config)
+	DatabaseV1Client, err := config.DatabaseV1Client(config.GetRegion())
+	if err != nil {
+		return fmt.Errorf("error creating VKCS database client: %s", err)
+	}
+
+	datastore, err := extractDatabaseDatastore(diff.Get("datastore").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("unable to determine vkcs_db_config_group datastore")
+	}
+
+	dsParameters, err := datastores.ListParameters(DatabaseV1Client, datastore.Type, datastore.Version).Extract()
+	if err != nil {
+		return fmt.Errorf("unable to determine vkcs_db_config_group parameter types: %s", err)
+	}
+
+	err = checkDatabaseConfigGroupValues(diff.Get("values").(map[string]interface{}), dsParameters)
+	if err != nil {
+		return fmt.Errorf("invalid vkcs_db_config_group values: %s", err)
+	}
+
+	return nil
 }
 
 func resourceDatabaseConfigGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -2,6 +2,7 @@ package db_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -46,6 +47,25 @@ func TestAccDatabaseConfigGroup_basic(t *testing.T) {
 	})
 }
 
+func TestAccDatabaseConfigGroup_invalidValues(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.AccTestPreCheck(t) },
+		ProviderFactories: acctest.AccTestProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      acctest.AccTestRenderConfig(testAccDatabaseConfigGroupUnknownParameter),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`incorrect parameter: max_conections`),
+			},
+			{
+				Config:      acctest.AccTestRenderConfig(testAccDatabaseConfigGroupOutOfRange),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`value of parameter max_connections must be between`),
+			},
+		},
+	})
+}
+
 func testAccCheckDatabaseConfigGroupExists(n string, configGroup *cg.ConfigGroupResp) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -196,3 +216,29 @@ resource "vkcs_db_instance" "basic" {
   depends_on = [vkcs_networking_router_interface.base]
 }
 `
+
+const testAccDatabaseConfigGroupUnknownParameter = `
+resource "vkcs_db_config_group" "basic" {
+	name = "basic"
+	datastore {
+		version = "13"
+		type = "postgresql"
+	}
+	values = {
+		max_conections: "100"
+	}
+}
+`
+
+const testAccDatabaseConfigGroupOutOfRange = `
+resource "vkcs_db_config_group" "basic" {
+	name = "basic"
+	datastore {
+		version = "13"
+		type = "postgresql"
+	}
+	values = {
+		max_connections: "-1"
+	}
+}
+`
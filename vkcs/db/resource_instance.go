@@ -9,8 +9,10 @@ import (
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/clients"
 	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/db/v1/instances"
 	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/util"
@@ -20,6 +22,7 @@ import (
 const (
 	dbInstanceDelay         = 10 * time.Second
 	dbInstanceMinTimeout    = 3 * time.Second
+	dbActionStartTimeout    = 1 * time.Minute
 	dbDatabaseDelay         = 10 * time.Second
 	dbDatabaseMinTimeout    = 3 * time.Second
 	dbUserDelay             = 10 * time.Second
@@ -45,6 +48,8 @@ var (
 	dbInstanceStatusCapabilityApplying dbInstanceStatus = "CAPABILITY_APPLYING"
 	dbInstanceStatusBackup             dbInstanceStatus = "BACKUP"
 	dbInstanceStatusRestartRequired    dbInstanceStatus = "RESTART_REQUIRED"
+	dbInstanceStatusReboot             dbInstanceStatus = "REBOOT"
+	dbInstanceStatusUpgrade            dbInstanceStatus = "UPGRADE"
 )
 
 type dbCapabilityStatus string
@@ -64,7 +69,10 @@ func ResourceDatabaseInstance() *schema.Resource {
 		ReadContext:   resourceDatabaseInstanceRead,
 		DeleteContext: resourceDatabaseInstanceDelete,
 		UpdateContext: resourceDatabaseInstanceUpdate,
-		CustomizeDiff: resourceDatabaseCustomizeDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceDatabaseCustomizeDiff,
+			resourceDatabaseDatastoreUpgradeCustomizeDiff,
+		),
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				config := meta.(clients.Config)
@@ -181,15 +189,15 @@ func ResourceDatabaseInstance() *schema.Resource {
 			"datastore": {
 				Type:     schema.TypeList,
 				Required: true,
-				ForceNew: true,
+				ForceNew: false,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"version": {
 							Type:        schema.TypeString,
 							Required:    true,
-							ForceNew:    true,
-							Description: "Version of the datastore. Changing this creates a new instance.",
+							ForceNew:    false,
+							Description: "Version of the datastore. Changing this upgrades the instance in place. Only upgrades to newer versions are allowed.",
 						},
 						"type": {
 							Type:     schema.TypeString,
@@ -202,7 +210,7 @@ func ResourceDatabaseInstance() *schema.Resource {
 						},
 					},
 				},
-				Description: "Object that represents datastore of the instance. Changing this creates a new instance.",
+				Description: "Object that represents datastore of the instance. Changing type of the datastore creates a new instance.",
 			},
 
 			"network": {
@@ -441,6 +449,36 @@ func ResourceDatabaseInstance() *schema.Resource {
 				Description: "Object that represents configuration of PITR backup. This functionality is available only for postgres datastore.",
 			},
 
+			"maintenance_window": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"day": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(databaseMaintenanceWindowDays, false),
+							Description:  "Day of the week to start maintenance. Must be one of: `mon`, `tue`, `wed`, `thu`, `fri`, `sat`, `sun`.",
+						},
+						"start_hour": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 23),
+							Description:  "Hour of the day (UTC) to start maintenance.",
+						},
+						"duration": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 24),
+							Description:  "Duration of maintenance in hours.",
+						},
+					},
+				},
+				Description: "Object that represents weekly window in which maintenance of the instance, e.g. restarts and minor updates, is performed. If omitted, the window is chosen by the service.",
+			},
+
 			"cloud_monitoring_enabled": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -448,6 +486,14 @@ func ResourceDatabaseInstance() *schema.Resource {
 				Description: "Enable cloud monitoring for the instance. Changing this for Redis creates a new instance.",
 			},
 
+			"published_logs": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "Names of the instance logs to enable and publish, e.g. `slow_query`. Available logs depend on the datastore and can be obtained with `vkcs_db_instance_logs` data source. Removing a log from this set disables it.",
+			},
+
 			"vendor_options": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -592,6 +638,14 @@ func resourceDatabaseInstanceCreate(ctx context.Context, d *schema.ResourceData,
 		createOpts.BackupSchedule = &backupSchedule
 	}
 
+	if v, ok := d.GetOk("maintenance_window"); ok {
+		maintenanceWindow, err := extractDatabaseMaintenanceWindow(v.([]interface{}))
+		if err != nil {
+			return diag.Errorf("%s maintenance_window", message)
+		}
+		createOpts.MaintenanceWindow = &maintenanceWindow
+	}
+
 	var checkCapabilities *[]instances.CapabilityOpts
 	if capabilities, ok := d.GetOk("capabilities"); ok {
 		capabilitiesOpts, err := extractDatabaseCapabilities(capabilities.([]interface{}))
@@ -678,6 +732,13 @@ func resourceDatabaseInstanceCreate(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
+	if v, ok := d.GetOk("published_logs"); ok {
+		err := databaseInstancePublishLogs(DatabaseV1Client, instance.ID, util.ExpandToStringSlice(v.(*schema.Set).List()))
+		if err != nil {
+			return diag.Errorf("error publishing logs of vkcs_db_instance %s: %s", instance.ID, err)
+		}
+	}
+
 	return resourceDatabaseInstanceRead(ctx, d, meta)
 }
 
@@ -732,6 +793,22 @@ func resourceDatabaseInstanceRead(ctx context.Context, d *schema.ResourceData, m
 		d.Set("backup_schedule", nil)
 	}
 
+	if instance.MaintenanceWindow != nil {
+		d.Set("maintenance_window", flattenDatabaseMaintenanceWindow(*instance.MaintenanceWindow))
+	} else {
+		d.Set("maintenance_window", nil)
+	}
+
+	// Logs are listed only if they are managed, since not all datastores
+	// support them
+	if publishedLogs := d.Get("published_logs").(*schema.Set); publishedLogs.Len() > 0 {
+		logs, err := instances.ListLogs(DatabaseV1Client, d.Id()).Extract()
+		if err != nil {
+			return diag.Errorf("error listing logs of vkcs_db_instance %s: %s", d.Id(), err)
+		}
+		d.Set("published_logs", getDatabaseInstancePublishedLogs(logs, util.ExpandToStringSlice(publishedLogs.List())))
+	}
+
 	d.Set("ip", instance.IP)
 
 	if !d.HasChangesExcept() {
@@ -830,6 +907,30 @@ func resourceDatabaseInstanceUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
+	if d.HasChange("datastore.0.version") {
+		var upgradeOpts instances.UpgradeOpts
+		upgradeOpts.Instance.DatastoreVersion = d.Get("datastore.0.version").(string)
+		err := instances.Update(DatabaseV1Client, d.Id(), &upgradeOpts).ExtractErr()
+		if err != nil {
+			return diag.Errorf("error upgrading datastore version of vkcs_db_instance %s: %s", d.Id(), err)
+		}
+		log.Printf("[DEBUG] Upgrading datastore version of vkcs_db_instance %s to %s", d.Id(), upgradeOpts.Instance.DatastoreVersion)
+
+		err = waitForDatabaseActionStart(ctx, databaseInstanceStateRefreshFunc(DatabaseV1Client, d.Id(), nil),
+			string(dbInstanceStatusActive), []string{string(dbInstanceStatusUpgrade), string(dbInstanceStatusReboot)})
+		if err != nil {
+			return diag.Errorf("error waiting for vkcs_db_instance %s to start upgrade: %s", d.Id(), err)
+		}
+
+		stateConf.Pending = []string{string(dbInstanceStatusUpgrade), string(dbInstanceStatusReboot)}
+		stateConf.Target = []string{string(dbInstanceStatusActive)}
+
+		_, err = stateConf.WaitForStateContext(ctx)
+		if err != nil {
+			return diag.Errorf("error waiting for vkcs_db_instance %s to become ready: %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("size") {
 		_, new := d.GetChange("size")
 		var resizeVolumeOpts instances.ResizeVolumeOpts
@@ -1050,6 +1151,19 @@ func resourceDatabaseInstanceUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
+	if d.HasChange("maintenance_window") {
+		maintenanceWindow, err := extractDatabaseMaintenanceWindow(d.Get("maintenance_window").([]interface{}))
+		if err != nil {
+			return diag.Errorf("unable to determine vkcs_db_instance maintenance_window")
+		}
+		var maintenanceWindowOpts instances.UpdateMaintenanceWindowOpts
+		maintenanceWindowOpts.Instance.MaintenanceWindow = maintenanceWindow
+		err = instances.Update(DatabaseV1Client, d.Id(), &maintenanceWindowOpts).ExtractErr()
+		if err != nil {
+			return diag.Errorf("error updating maintenance window for vkcs_db_instance %s: %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("cloud_monitoring_enabled") {
 		_, new := d.GetChange("cloud_monitoring_enabled")
 		var cloudMonitoringOpts instances.UpdateCloudMonitoringOpts
@@ -1062,6 +1176,20 @@ func resourceDatabaseInstanceUpdate(ctx context.Context, d *schema.ResourceData,
 		log.Printf("Update cloud_monitoring_enabled in vkcs_db_instance %s", d.Id())
 	}
 
+	if d.HasChange("published_logs") {
+		o, n := d.GetChange("published_logs")
+		oldLogs, newLogs := o.(*schema.Set), n.(*schema.Set)
+
+		err := databaseInstanceDisableLogs(DatabaseV1Client, d.Id(), util.ExpandToStringSlice(oldLogs.Difference(newLogs).List()))
+		if err != nil {
+			return diag.Errorf("error disabling logs of vkcs_db_instance %s: %s", d.Id(), err)
+		}
+		err = databaseInstancePublishLogs(DatabaseV1Client, d.Id(), util.ExpandToStringSlice(newLogs.Difference(oldLogs).List()))
+		if err != nil {
+			return diag.Errorf("error publishing logs of vkcs_db_instance %s: %s", d.Id(), err)
+		}
+	}
+
 	return resourceDatabaseInstanceRead(ctx, d, meta)
 }
 
@@ -2,6 +2,7 @@ package db_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -110,6 +111,47 @@ func TestAccDatabaseInstance_wal_no_update(t *testing.T) {
 	})
 }
 
+func TestAccDatabaseInstance_upgrade_big(t *testing.T) {
+	var instance, upgradedInstance instances.InstanceResp
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.AccTestPreCheck(t) },
+		ProviderFactories: acctest.AccTestProviders,
+		CheckDestroy:      testAccCheckDatabaseInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.AccTestRenderConfig(testAccDatabaseInstanceUpgrade, map[string]string{"Version": "15", "Day": "sun"}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatabaseInstanceExists("vkcs_db_instance.upgrade", &instance),
+					resource.TestCheckResourceAttr("vkcs_db_instance.upgrade", "datastore.0.version", "15"),
+					resource.TestCheckResourceAttr("vkcs_db_instance.upgrade", "maintenance_window.0.day", "sun"),
+					resource.TestCheckResourceAttr("vkcs_db_instance.upgrade", "maintenance_window.0.start_hour", "3"),
+					resource.TestCheckResourceAttr("vkcs_db_instance.upgrade", "maintenance_window.0.duration", "2"),
+				),
+			},
+			{
+				Config: acctest.AccTestRenderConfig(testAccDatabaseInstanceUpgrade, map[string]string{"Version": "16", "Day": "sat"}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatabaseInstanceExists("vkcs_db_instance.upgrade", &upgradedInstance),
+					func(s *terraform.State) error {
+						if instance.ID != upgradedInstance.ID {
+							return fmt.Errorf("instance was recreated: %s != %s", instance.ID, upgradedInstance.ID)
+						}
+						return nil
+					},
+					resource.TestCheckResourceAttr("vkcs_db_instance.upgrade", "datastore.0.version", "16"),
+					resource.TestCheckResourceAttr("vkcs_db_instance.upgrade", "maintenance_window.0.day", "sat"),
+				),
+			},
+			{
+				Config:      acctest.AccTestRenderConfig(testAccDatabaseInstanceUpgrade, map[string]string{"Version": "15", "Day": "sat"}),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("datastore version downgrade is not allowed"),
+			},
+		},
+	})
+}
+
 func testAccCheckDatabaseInstanceExists(n string, instance *instances.InstanceResp) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -317,3 +359,33 @@ resource "vkcs_db_instance" "basic" {
   depends_on = [vkcs_networking_router_interface.base]
 }
 `
+
+const testAccDatabaseInstanceUpgrade = `
+{{.BaseNetwork}}
+{{.BaseFlavor}}
+
+resource "vkcs_db_instance" "upgrade" {
+  name        = "upgrade"
+  flavor_id   = data.vkcs_compute_flavor.base.id
+  size        = 8
+  volume_type = "{{.VolumeType}}"
+
+  datastore {
+    version = "{{.Version}}"
+    type    = "postgresql"
+  }
+
+  maintenance_window {
+    day        = "{{.Day}}"
+    start_hour = 3
+    duration   = 2
+  }
+
+  network {
+    uuid = vkcs_networking_network.base.id
+  }
+  availability_zone = "{{.AvailabilityZone}}"
+
+  depends_on = [vkcs_networking_router_interface.base]
+}
+`
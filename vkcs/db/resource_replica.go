@@ -0,0 +1,441 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/clients"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/db/v1/instances"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/util"
+)
+
+func ResourceDatabaseReplica() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDatabaseReplicaCreate,
+		ReadContext:   resourceDatabaseReplicaRead,
+		DeleteContext: resourceDatabaseReplicaDelete,
+		UpdateContext: resourceDatabaseReplicaUpdate,
+		CustomizeDiff: resourceDatabaseReplicaCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				if resourceDatabaseReplicaRead(ctx, d, meta).HasError() {
+					return nil, fmt.Errorf("error reading vkcs_db_replica")
+				}
+				if v := d.Get("replica_of").(string); v != "" {
+					d.Set("source_instance_id", v)
+					d.Set("promote", false)
+				} else {
+					d.Set("promote", true)
+				}
+				d.Set("volume_type", dbImportedStatus)
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(dbCreateTimeout),
+			Update: schema.DefaultTimeout(dbCreateTimeout),
+			Delete: schema.DefaultTimeout(dbDeleteTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Region to create resource in.",
+			},
+
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the replica. Changing this creates a new replica.",
+			},
+
+			"source_instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					// Source of an imported promoted replica is unknown
+					return old == "" && d.Get("promote").(bool)
+				},
+				Description: fmt.Sprintf("ID of the instance to replicate. Instance's datastore must be one of: %s. Changing this creates a new replica.", strings.Join(datastoresWithQuotes(getReplicaDatastores()), ", ")),
+			},
+
+			"flavor_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of flavor for the replica.",
+			},
+
+			"size": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "Size of the replica volume.",
+			},
+
+			"volume_type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The type of the replica volume. Changing this creates a new replica.",
+			},
+
+			"network": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uuid": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							ForceNew:    true,
+							Description: "The id of the network. Changing this creates a new replica.",
+						},
+						"subnet_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							ForceNew:    true,
+							Description: "The id of the subnet. Changing this creates a new replica.",
+						},
+						"security_groups": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							ForceNew:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "An array of one or more security group IDs to associate with the replica. Changing this creates a new replica.",
+						},
+					},
+				},
+				Description: "Object that represents network of the replica. Changing this creates a new replica.",
+			},
+
+			"availability_zone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The name of the availability zone of the replica. Changing this creates a new replica.",
+			},
+
+			"floating_ip_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Indicates whether floating ip is created for replica. Changing this creates a new replica.",
+			},
+
+			"keypair": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Name of the keypair to be attached to replica. Changing this creates a new replica.",
+			},
+
+			"configuration_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The id of the configuration attached to replica. Changing this creates a new replica.",
+			},
+
+			"promote": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Set to true to detach the replica from the source instance and turn it into a standalone instance. The replica is not recreated. A promoted replica can not become a replica again.",
+			},
+
+			"datastore": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Version of the datastore.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the datastore.",
+						},
+					},
+				},
+				Description: "Object that represents datastore of the replica. It is inherited from the source instance.",
+			},
+
+			"replica_of": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the instance, that the replica currently replicates. Empty after promotion.",
+			},
+
+			"replication_lag": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Replication lag of the replica in seconds. Set only if it is reported by the API.",
+			},
+
+			"ip": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IP address of the replica.",
+			},
+		},
+		Description: "Provides a db replica resource. This can be used to create, modify, promote and delete read replicas of db instances.",
+	}
+}
+
+func resourceDatabaseReplicaCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(clients.Config)
+	DatabaseV1Client, err := config.DatabaseV1Client(util.GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating VKCS database client: %s", err)
+	}
+
+	sourceInstanceID := d.Get("source_instance_id").(string)
+	source, err := instances.Get(DatabaseV1Client, sourceInstanceID).Extract()
+	if err != nil {
+		return diag.Errorf("error retrieving source instance %s of vkcs_db_replica: %s", sourceInstanceID, err)
+	}
+
+	err = checkReplicaDatastore(source.DataStore.Type)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	size := d.Get("size").(int)
+	createOpts := &instances.CreateOpts{
+		FlavorRef:         d.Get("flavor_id").(string),
+		Name:              d.Get("name").(string),
+		Volume:            &instances.Volume{Size: &size, VolumeType: d.Get("volume_type").(string)},
+		Datastore:         source.DataStore,
+		ReplicaOf:         sourceInstanceID,
+		AvailabilityZone:  d.Get("availability_zone").(string),
+		FloatingIPEnabled: d.Get("floating_ip_enabled").(bool),
+		Keypair:           d.Get("keypair").(string),
+		Configuration:     d.Get("configuration_id").(string),
+	}
+
+	createOpts.Nics, createOpts.SecurityGroups, err = extractDatabaseNetworks(d.Get("network").([]interface{}))
+	if err != nil {
+		return diag.Errorf("unable to determine vkcs_db_replica network")
+	}
+
+	log.Printf("[DEBUG] vkcs_db_replica create options: %#v", createOpts)
+
+	inst := instances.Instance{}
+	inst.Instance = createOpts
+	instance, err := instances.Create(DatabaseV1Client, &inst).Extract()
+	if err != nil {
+		return diag.Errorf("error creating vkcs_db_replica: %s", err)
+	}
+
+	// Store the ID now
+	d.SetId(instance.ID)
+
+	// Wait for the replica to become available.
+	log.Printf("[DEBUG] Waiting for vkcs_db_replica %s to become available", instance.ID)
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{string(dbInstanceStatusBuild), string(dbInstanceStatusBackup)},
+		Target:     []string{string(dbInstanceStatusActive)},
+		Refresh:    databaseInstanceStateRefreshFunc(DatabaseV1Client, instance.ID, nil),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      dbInstanceDelay,
+		MinTimeout: dbInstanceMinTimeout,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("error waiting for vkcs_db_replica %s to become ready: %s", instance.ID, err)
+	}
+
+	if d.Get("promote").(bool) {
+		err = databaseReplicaPromote(ctx, DatabaseV1Client, d, stateConf, sourceInstanceID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceDatabaseReplicaRead(ctx, d, meta)
+}
+
+func resourceDatabaseReplicaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(clients.Config)
+	DatabaseV1Client, err := config.DatabaseV1Client(util.GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating VKCS database client: %s", err)
+	}
+
+	instance, err := instances.Get(DatabaseV1Client, d.Id()).Extract()
+	if err != nil {
+		return diag.FromErr(util.CheckDeleted(d, err, "Error retrieving vkcs_db_replica"))
+	}
+
+	log.Printf("[DEBUG] Retrieved vkcs_db_replica %s: %#v", d.Id(), instance)
+
+	d.Set("name", instance.Name)
+	d.Set("flavor_id", instance.Flavor.ID)
+	d.Set("datastore", flattenDatabaseInstanceDatastore(*instance.DataStore))
+	d.Set("region", util.GetRegion(d, config))
+	d.Set("size", instance.Volume.Size)
+	d.Set("configuration_id", instance.ConfigurationID)
+	d.Set("ip", instance.IP)
+
+	if instance.ReplicaOf != nil {
+		d.Set("replica_of", instance.ReplicaOf.ID)
+	} else {
+		d.Set("replica_of", "")
+	}
+
+	if instance.ReplicationLag != nil {
+		d.Set("replication_lag", *instance.ReplicationLag)
+	} else {
+		d.Set("replication_lag", nil)
+	}
+
+	return nil
+}
+
+func resourceDatabaseReplicaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(clients.Config)
+	DatabaseV1Client, err := config.DatabaseV1Client(util.GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating VKCS database client: %s", err)
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{string(dbInstanceStatusBuild)},
+		Target:     []string{string(dbInstanceStatusActive)},
+		Refresh:    databaseInstanceStateRefreshFunc(DatabaseV1Client, d.Id(), nil),
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Delay:      dbInstanceDelay,
+		MinTimeout: dbInstanceMinTimeout,
+	}
+
+	if d.HasChange("size") {
+		var resizeVolumeOpts instances.ResizeVolumeOpts
+		resizeVolumeOpts.Resize.Volume.Size = d.Get("size").(int)
+		err := instances.Action(DatabaseV1Client, d.Id(), &resizeVolumeOpts).ExtractErr()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		log.Printf("Resizing volume from vkcs_db_replica %s", d.Id())
+
+		stateConf.Pending = []string{string(dbInstanceStatusResize)}
+		stateConf.Target = []string{string(dbInstanceStatusActive)}
+
+		_, err = stateConf.WaitForStateContext(ctx)
+		if err != nil {
+			return diag.Errorf("error waiting for vkcs_db_replica %s to become ready: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("flavor_id") {
+		var resizeOpts instances.ResizeOpts
+		resizeOpts.Resize.FlavorRef = d.Get("flavor_id").(string)
+		err := instances.Action(DatabaseV1Client, d.Id(), &resizeOpts).ExtractErr()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		log.Printf("Resizing flavor from vkcs_db_replica %s", d.Id())
+
+		stateConf.Pending = []string{string(dbInstanceStatusResize)}
+		stateConf.Target = []string{string(dbInstanceStatusActive)}
+
+		_, err = stateConf.WaitForStateContext(ctx)
+		if err != nil {
+			return diag.Errorf("error waiting for vkcs_db_replica %s to become ready: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("promote") && d.Get("promote").(bool) {
+		replicaOf := d.Get("replica_of").(string)
+		if replicaOf == "" {
+			replicaOf = d.Get("source_instance_id").(string)
+		}
+		err = databaseReplicaPromote(ctx, DatabaseV1Client, d, stateConf, replicaOf)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceDatabaseReplicaRead(ctx, d, meta)
+}
+
+func resourceDatabaseReplicaDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(clients.Config)
+	DatabaseV1Client, err := config.DatabaseV1Client(util.GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating VKCS database client: %s", err)
+	}
+
+	err = instances.Delete(DatabaseV1Client, d.Id()).ExtractErr()
+	if err != nil {
+		return diag.FromErr(util.CheckDeleted(d, err, "Error deleting vkcs_db_replica"))
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{string(dbInstanceStatusActive), string(dbInstanceStatusBackup),
+			string(dbInstanceStatusRestartRequired), string(dbInstanceStatusShutdown)},
+		Target:     []string{string(dbInstanceStatusDeleted)},
+		Refresh:    databaseInstanceStateRefreshFunc(DatabaseV1Client, d.Id(), nil),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      dbInstanceDelay,
+		MinTimeout: dbInstanceMinTimeout,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("error waiting for vkcs_db_replica %s to delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceDatabaseReplicaCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+	if diff.Id() == "" || !diff.HasChange("promote") {
+		return nil
+	}
+	if old, _ := diff.GetChange("promote"); old.(bool) {
+		return fmt.Errorf("vkcs_db_replica %s is already promoted and can not become a replica again", diff.Id())
+	}
+	return nil
+}
+
+// databaseReplicaPromote detaches the replica from its source instance, so it
+// becomes a standalone instance.
+func databaseReplicaPromote(ctx context.Context, client *gophercloud.ServiceClient, d *schema.ResourceData, stateConf *retry.StateChangeConf, replicaOf string) error {
+	detachReplicaOpts := &instances.DetachReplicaOpts{}
+	detachReplicaOpts.Instance.ReplicaOf = replicaOf
+	err := instances.DetachReplica(client, d.Id(), detachReplicaOpts).ExtractErr()
+	if err != nil {
+		return fmt.Errorf("error promoting vkcs_db_replica %s: %s", d.Id(), err)
+	}
+	log.Printf("Detach replica from vkcs_db_replica %s", d.Id())
+
+	stateConf.Pending = []string{string(dbInstanceStatusDetach)}
+	stateConf.Target = []string{string(dbInstanceStatusActive)}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf("error waiting for vkcs_db_replica %s to become ready: %s", d.Id(), err)
+	}
+
+	return nil
+}
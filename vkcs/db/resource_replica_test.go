@@ -0,0 +1,109 @@
+package db_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/acctest"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/clients"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/db/v1/instances"
+)
+
+func TestAccDatabaseReplica_promote_big(t *testing.T) {
+	var replica instances.InstanceResp
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.AccTestPreCheck(t) },
+		ProviderFactories: acctest.AccTestProviders,
+		CheckDestroy:      testAccCheckDatabaseReplicaDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.AccTestRenderConfig(testAccDatabaseReplicaBasic, map[string]string{"Promote": "false"}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatabaseInstanceExists(
+						"vkcs_db_replica.basic", &replica),
+					resource.TestCheckResourceAttrPair(
+						"vkcs_db_replica.basic", "replica_of", "vkcs_db_instance.source", "id"),
+					resource.TestCheckResourceAttr(
+						"vkcs_db_replica.basic", "datastore.0.type", "postgresql"),
+				),
+			},
+			{
+				Config: acctest.AccTestRenderConfig(testAccDatabaseReplicaBasic, map[string]string{"Promote": "true"}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatabaseInstanceExists(
+						"vkcs_db_replica.basic", &replica),
+					resource.TestCheckResourceAttrPtr(
+						"vkcs_db_replica.basic", "id", &replica.ID),
+					resource.TestCheckResourceAttr(
+						"vkcs_db_replica.basic", "replica_of", ""),
+					resource.TestCheckResourceAttr(
+						"vkcs_db_replica.basic", "promote", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDatabaseReplicaDestroy(s *terraform.State) error {
+	config := acctest.AccTestProvider.Meta().(clients.Config)
+
+	DatabaseClient, err := config.DatabaseV1Client(acctest.OsRegionName)
+	if err != nil {
+		return fmt.Errorf("Error creating VKCS database client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vkcs_db_replica" && rs.Type != "vkcs_db_instance" {
+			continue
+		}
+
+		_, err := instances.Get(DatabaseClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("instance still exists")
+		}
+	}
+
+	return nil
+}
+
+const testAccDatabaseReplicaBasic = `
+{{.BaseNetwork}}
+{{.BaseFlavor}}
+
+resource "vkcs_db_instance" "source" {
+  name        = "source"
+  flavor_id   = data.vkcs_compute_flavor.base.id
+  size        = 8
+  volume_type = "{{.VolumeType}}"
+
+  datastore {
+    version = "13"
+    type    = "postgresql"
+  }
+
+  network {
+    uuid = vkcs_networking_network.base.id
+  }
+  availability_zone = "{{.AvailabilityZone}}"
+
+  depends_on = [vkcs_networking_router_interface.base]
+}
+
+resource "vkcs_db_replica" "basic" {
+  name               = "replica"
+  source_instance_id = vkcs_db_instance.source.id
+  flavor_id          = data.vkcs_compute_flavor.base.id
+  size               = 8
+  volume_type        = "{{.VolumeType}}"
+
+  network {
+    uuid = vkcs_networking_network.base.id
+  }
+  availability_zone = "{{.AvailabilityZone}}"
+
+  promote = {{.Promote}}
+}
+`
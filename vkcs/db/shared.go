@@ -3,9 +3,13 @@ package db
 import (
 	"context"
 	"errors"
+	"fmt"
+	"log"
 
 	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/util"
 )
@@ -23,6 +27,71 @@ func resourceDatabaseCustomizeDiff(_ context.Context, diff *schema.ResourceDiff,
 	return nil
 }
 
+// waitForDatabaseActionStart waits for a resource to leave its active status
+// after an action was requested, so that a following wait for the active
+// status does not return before the action began. The action may be too
+// quick to be noticed, so a timeout is not an error.
+func waitForDatabaseActionStart(ctx context.Context, refresh retry.StateRefreshFunc, active string, target []string) error {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{active},
+		Target:     target,
+		Refresh:    refresh,
+		Timeout:    dbActionStartTimeout,
+		Delay:      dbInstanceMinTimeout,
+		MinTimeout: dbInstanceMinTimeout,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	var timeoutErr *retry.TimeoutError
+	if errors.As(err, &timeoutErr) {
+		log.Printf("[DEBUG] Action did not start within %s", dbActionStartTimeout)
+		return nil
+	}
+	return err
+}
+
+var databaseMaintenanceWindowDays = []string{"mon", "tue", "wed", "thu", "fri", "sat", "sun"}
+
+// resourceDatabaseDatastoreUpgradeCustomizeDiff allows to change datastore
+// version of an existing resource only to a newer one, since versions are
+// upgraded in place and downgrades are not supported.
+func resourceDatabaseDatastoreUpgradeCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+	if diff.Id() == "" || !diff.HasChange("datastore.0.version") || diff.HasChange("datastore.0.type") {
+		return nil
+	}
+	if !diff.NewValueKnown("datastore.0.version") {
+		return nil
+	}
+
+	o, n := diff.GetChange("datastore.0.version")
+	oldVersion, newVersion := o.(string), n.(string)
+	if oldVersion == "" {
+		return nil
+	}
+	c, err := compareDatabaseVersions(newVersion, oldVersion)
+	if err != nil {
+		return fmt.Errorf("unable to compare datastore versions %s and %s: %s", oldVersion, newVersion, err)
+	}
+	if c < 0 {
+		return fmt.Errorf("datastore version downgrade is not allowed: cannot downgrade from %s to %s, only upgrades to newer versions are permitted",
+			oldVersion, newVersion)
+	}
+	return nil
+}
+
+// compareDatabaseVersions compares datastore versions, e.g. "13" is newer
+// than "9.6" and "8.0.32-ubuntu" is newer than "8.0.4-ubuntu".
+func compareDatabaseVersions(a, b string) (int, error) {
+	aVersion, err := version.NewVersion(a)
+	if err != nil {
+		return 0, err
+	}
+	bVersion, err := version.NewVersion(b)
+	if err != nil {
+		return 0, err
+	}
+	return aVersion.Compare(bVersion), nil
+}
+
 func checkDBNetworks(
 	rawNetworks []interface{}, path cty.Path, diags diag.Diagnostics,
 ) diag.Diagnostics {
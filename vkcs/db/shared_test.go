@@ -0,0 +1,49 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/db/v1/instances"
+)
+
+func TestCompareDatabaseVersions(t *testing.T) {
+	cases := []struct {
+		a, b     string
+		expected int
+	}{
+		{"8.0", "8.0", 0},
+		{"8.0", "5.7", 1},
+		{"5.7", "8.0", -1},
+		{"13", "9.6", 1},
+		{"16", "16.0", 0},
+		{"24.3", "24.8", -1},
+		{"8.0.36", "8.0.4", 1},
+		{"13-vk1", "13-vk10", -1},
+		{"8.0.32-ubuntu", "8.0.4-ubuntu", 1},
+	}
+
+	for _, c := range cases {
+		actual, err := compareDatabaseVersions(c.a, c.b)
+		assert.NoError(t, err, "%s vs %s", c.a, c.b)
+		assert.Equal(t, c.expected, actual, "%s vs %s", c.a, c.b)
+	}
+
+	_, err := compareDatabaseVersions("latest", "8.0")
+	assert.Error(t, err)
+	_, err = compareDatabaseVersions("8.0", "")
+	assert.Error(t, err)
+}
+
+func TestGetDatabaseInstancePublishedLogs(t *testing.T) {
+	logs := []instances.Log{
+		{Name: "general", Status: "Disabled"},
+		{Name: "slow_query", Status: "Published"},
+		{Name: "error", Status: "Enabled"},
+		{Name: "audit", Status: "Unavailable"},
+	}
+
+	assert.Equal(t, []string{"slow_query", "error"}, getDatabaseInstancePublishedLogs(logs, []string{"slow_query", "error", "audit"}))
+	assert.Equal(t, []string{}, getDatabaseInstancePublishedLogs(logs, []string{"general"}))
+	assert.Equal(t, []string{}, getDatabaseInstancePublishedLogs(logs, nil))
+}
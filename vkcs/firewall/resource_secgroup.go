@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/clients"
 	irules "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/firewall/v2/rules"
 	inetworking "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/networking"
@@ -63,6 +64,76 @@ func ResourceNetworkingSecGroup() *schema.Resource {
 				Description: "Whether or not to delete the default egress security rules. This is `false` by default. See the below note for more information.",
 			},
 
+			"rule": {
+				Type:       schema.TypeSet,
+				Optional:   true,
+				Computed:   true,
+				ConfigMode: schema.SchemaConfigModeAttr,
+				Set:        networkingSecGroupRuleHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the rule.",
+						},
+
+						"direction": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"ingress", "egress"}, false),
+							Description:  "The direction of the rule, valid values are __ingress__ or __egress__.",
+						},
+
+						"ethertype": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"IPv4"}, false),
+							Description:  "The layer 3 protocol type, the only valid value is __IPv4__. Defaults to __IPv4__.",
+						},
+
+						"protocol": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The layer 4 protocol type. Accepts the same values as `protocol` argument of `vkcs_networking_secgroup_rule`. This is required if you want to specify a port range.",
+						},
+
+						"port_range_min": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 65535),
+							Description:  "The lower part of the allowed port range, valid integer value needs to be between 1 and 65535. To specify all ports, `port_range_min` and `port_range_max` arguments must be absent.",
+						},
+
+						"port_range_max": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 65535),
+							Description:  "The higher part of the allowed port range, valid integer value needs to be between 1 and 65535. To specify all ports, `port_range_min` and `port_range_max` arguments must be absent.",
+						},
+
+						"remote_ip_prefix": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The remote CIDR, the value needs to be a valid CIDR (i.e. 192.168.0.0/16). _note_ Only one of `remote_group_id` or `remote_ip_prefix` may be set.",
+						},
+
+						"remote_group_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The remote group id, the value needs to be an ID of a security group in the same tenant. _note_ Only one of `remote_group_id` or `remote_ip_prefix` may be set.",
+						},
+
+						"description": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "A description of the rule.",
+						},
+					},
+				},
+				Description: "A set of rules of the security group. If specified, the set is authoritative: rules of the security group which are not in the set, including default rules and rules created outside of Terraform, are planned for removal. Rules are matched by their arguments, so reordering of rules does not recreate them. Set `rule = []` to remove all rules. If omitted, rules are not managed and are only reported. See the below note for more information.",
+			},
+
 			"tags": {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -126,6 +197,20 @@ func resourceNetworkingSecGroupCreate(ctx context.Context, d *schema.ResourceDat
 		}
 	}
 
+	// Make rules match the configured ones if they are managed inline.
+	if !d.GetRawConfig().GetAttr("rule").IsNull() {
+		sgID := sg.ID
+		sg, err := igroups.Get(networkingClient, sgID).Extract()
+		if err != nil {
+			return diag.Errorf("Error retrieving the created vkcs_networking_secgroup %s: %s", sgID, err)
+		}
+
+		err = networkingSecGroupSyncRules(networkingClient, sgID, sg.Rules, d.Get("rule").(*schema.Set))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	tags := networking.NetworkingAttributesTags(d)
 	if len(tags) > 0 {
 		tagOpts := attributestags.ReplaceAllOpts{Tags: tags}
@@ -159,6 +244,10 @@ func resourceNetworkingSecGroupRead(ctx context.Context, d *schema.ResourceData,
 	d.Set("region", util.GetRegion(d, config))
 	d.Set("sdn", sg.SDN)
 
+	if err := d.Set("rule", flattenNetworkingSecGroupRules(sg.Rules, d.Get("rule").(*schema.Set))); err != nil {
+		log.Printf("[DEBUG] Unable to set vkcs_networking_secgroup %s rules: %s", d.Id(), err)
+	}
+
 	networking.NetworkingReadAttributesTags(d, sg.Tags)
 
 	return nil
@@ -195,6 +284,22 @@ func resourceNetworkingSecGroupUpdate(ctx context.Context, d *schema.ResourceDat
 		}
 	}
 
+	if d.HasChange("rule") {
+		mutex := config.GetMutex()
+		mutex.Lock(d.Id())
+		defer mutex.Unlock(d.Id())
+
+		sg, err := igroups.Get(networkingClient, d.Id()).Extract()
+		if err != nil {
+			return diag.Errorf("Error retrieving vkcs_networking_secgroup %s: %s", d.Id(), err)
+		}
+
+		err = networkingSecGroupSyncRules(networkingClient, d.Id(), sg.Rules, d.Get("rule").(*schema.Set))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if d.HasChange("tags") {
 		tags := networking.NetworkingV2UpdateAttributesTags(d)
 		tagOpts := attributestags.ReplaceAllOpts{Tags: tags}
@@ -60,6 +60,43 @@ func TestAccFirewallSecGroup_noDefaultRules(t *testing.T) {
 	})
 }
 
+func TestAccFirewallSecGroup_inlineRules(t *testing.T) {
+	var securityGroup groups.SecGroup
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.AccTestPreCheck(t) },
+		ProviderFactories: acctest.AccTestProviders,
+		CheckDestroy:      testAccFirewallCheckSecGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallSecGroupInlineRules,
+				Check: resource.ComposeTestCheckFunc(
+					testAccFirewallCheckSecGroupExists("vkcs_networking_secgroup.secgroup_1", &securityGroup),
+					testAccFirewallCheckSecGroupRuleCount(&securityGroup, 2),
+					resource.TestCheckResourceAttr("vkcs_networking_secgroup.secgroup_1", "rule.#", "2"),
+				),
+			},
+			{
+				Config:   testAccFirewallSecGroupInlineRulesReordered,
+				PlanOnly: true,
+			},
+			{
+				Config: testAccFirewallSecGroupInlineRulesUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccFirewallCheckSecGroupExists("vkcs_networking_secgroup.secgroup_1", &securityGroup),
+					testAccFirewallCheckSecGroupRuleCount(&securityGroup, 1),
+					resource.TestCheckResourceAttr("vkcs_networking_secgroup.secgroup_1", "rule.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "vkcs_networking_secgroup.secgroup_1",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccFirewallSecGroup_timeout(t *testing.T) {
 	var securityGroup groups.SecGroup
 
@@ -175,3 +212,56 @@ resource "vkcs_networking_secgroup" "secgroup_1" {
   }
 }
 `
+
+const testAccFirewallSecGroupInlineRules = `
+resource "vkcs_networking_secgroup" "secgroup_1" {
+  name        = "security_group_1"
+  description = "terraform security group acceptance test"
+
+  rule {
+    direction        = "ingress"
+    protocol         = "tcp"
+    port_range_min   = 22
+    port_range_max   = 22
+    remote_ip_prefix = "10.0.0.0/8"
+  }
+
+  rule {
+    direction = "egress"
+  }
+}
+`
+
+const testAccFirewallSecGroupInlineRulesReordered = `
+resource "vkcs_networking_secgroup" "secgroup_1" {
+  name        = "security_group_1"
+  description = "terraform security group acceptance test"
+
+  rule {
+    direction = "egress"
+  }
+
+  rule {
+    direction        = "ingress"
+    protocol         = "tcp"
+    port_range_min   = 22
+    port_range_max   = 22
+    remote_ip_prefix = "10.0.0.0/8"
+  }
+}
+`
+
+const testAccFirewallSecGroupInlineRulesUpdate = `
+resource "vkcs_networking_secgroup" "secgroup_1" {
+  name        = "security_group_1"
+  description = "terraform security group acceptance test"
+
+  rule {
+    direction        = "ingress"
+    protocol         = "tcp"
+    port_range_min   = 443
+    port_range_max   = 443
+    remote_ip_prefix = "0.0.0.0/0"
+  }
+}
+`
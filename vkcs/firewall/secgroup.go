@@ -1,12 +1,18 @@
 package firewall
 
 import (
+	"fmt"
 	"log"
+	"net"
+	"strings"
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/groups"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/rules"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	igroups "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/firewall/v2/groups"
+	irules "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/firewall/v2/rules"
 	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/networking"
 	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/util/errutil"
 )
@@ -50,3 +56,155 @@ func networkingSecgroupStateRefreshFuncDelete(networkingClient *gophercloud.Serv
 		return r, "ACTIVE", nil
 	}
 }
+
+// networkingSecGroupRuleHash computes a hash of an inline security group
+// rule based on its arguments only, so that rules are matched regardless of
+// their order and of the computed ID.
+func networkingSecGroupRuleHash(v interface{}) int {
+	m := v.(map[string]interface{})
+
+	ethertype := m["ethertype"].(string)
+	if ethertype == "" {
+		ethertype = string(rules.EtherType4)
+	}
+
+	remoteIPPrefix := strings.ToLower(m["remote_ip_prefix"].(string))
+	if _, ipNet, err := net.ParseCIDR(remoteIPPrefix); err == nil {
+		remoteIPPrefix = ipNet.String()
+	}
+
+	return schema.HashString(fmt.Sprintf("%s-%s-%s-%d-%d-%s-%s-%s",
+		m["direction"].(string),
+		ethertype,
+		strings.ToLower(m["protocol"].(string)),
+		m["port_range_min"].(int),
+		m["port_range_max"].(int),
+		remoteIPPrefix,
+		m["remote_group_id"].(string),
+		m["description"].(string),
+	))
+}
+
+func expandNetworkingSecGroupRule(sgID string, m map[string]interface{}) (rules.CreateOpts, error) {
+	opts := rules.CreateOpts{
+		SecGroupID:     sgID,
+		Description:    m["description"].(string),
+		PortRangeMin:   m["port_range_min"].(int),
+		PortRangeMax:   m["port_range_max"].(int),
+		RemoteGroupID:  m["remote_group_id"].(string),
+		RemoteIPPrefix: m["remote_ip_prefix"].(string),
+	}
+
+	if opts.RemoteGroupID != "" && opts.RemoteIPPrefix != "" {
+		return opts, fmt.Errorf("only one of remote_group_id or remote_ip_prefix may be set for a rule of vkcs_networking_secgroup")
+	}
+
+	direction, err := resourceNetworkingSecGroupRuleDirection(m["direction"].(string))
+	if err != nil {
+		return opts, err
+	}
+	opts.Direction = direction
+
+	ethertype := m["ethertype"].(string)
+	if ethertype == "" {
+		ethertype = string(rules.EtherType4)
+	}
+	opts.EtherType, err = resourceNetworkingSecGroupRuleEtherType(ethertype)
+	if err != nil {
+		return opts, err
+	}
+
+	if protocol := m["protocol"].(string); protocol != "" {
+		opts.Protocol, err = resourceNetworkingSecGroupRuleProtocol(strings.ToLower(protocol))
+		if err != nil {
+			return opts, err
+		}
+	} else if opts.PortRangeMin != 0 || opts.PortRangeMax != 0 {
+		return opts, fmt.Errorf("a protocol must be specified when using port_range_min and port_range_max for a rule of vkcs_networking_secgroup")
+	}
+
+	return opts, nil
+}
+
+// flattenNetworkingSecGroupRules converts security group rules to inline
+// rules. Arguments of a rule matching an existing one are kept as is to avoid
+// diffs caused by normalization of values by the networking API.
+func flattenNetworkingSecGroupRules(sgRules []rules.SecGroupRule, existing *schema.Set) []interface{} {
+	existingRules := make(map[int]map[string]interface{})
+	if existing != nil {
+		for _, r := range existing.List() {
+			m := r.(map[string]interface{})
+			existingRules[networkingSecGroupRuleHash(m)] = m
+		}
+	}
+
+	result := make([]interface{}, 0, len(sgRules))
+	for _, r := range sgRules {
+		rule := map[string]interface{}{
+			"id":               r.ID,
+			"direction":        r.Direction,
+			"ethertype":        r.EtherType,
+			"protocol":         r.Protocol,
+			"port_range_min":   r.PortRangeMin,
+			"port_range_max":   r.PortRangeMax,
+			"remote_ip_prefix": r.RemoteIPPrefix,
+			"remote_group_id":  r.RemoteGroupID,
+			"description":      r.Description,
+		}
+
+		if m, ok := existingRules[networkingSecGroupRuleHash(rule)]; ok {
+			existingRule := make(map[string]interface{}, len(m))
+			for k, v := range m {
+				existingRule[k] = v
+			}
+			existingRule["id"] = r.ID
+			rule = existingRule
+		}
+
+		result = append(result, rule)
+	}
+	return result
+}
+
+// networkingSecGroupSyncRules makes rules of the security group match the
+// desired set. Rules missing from the set are deleted, new rules are created
+// with a single bulk request.
+func networkingSecGroupSyncRules(client *gophercloud.ServiceClient, sgID string, current []rules.SecGroupRule, desired *schema.Set) error {
+	currentRules := make(map[int]string, len(current))
+	for _, r := range flattenNetworkingSecGroupRules(current, nil) {
+		m := r.(map[string]interface{})
+		currentRules[networkingSecGroupRuleHash(m)] = m["id"].(string)
+	}
+
+	var createOpts irules.CreateBulkOpts
+	for _, r := range desired.List() {
+		m := r.(map[string]interface{})
+		hash := networkingSecGroupRuleHash(m)
+		if _, ok := currentRules[hash]; ok {
+			delete(currentRules, hash)
+			continue
+		}
+
+		opts, err := expandNetworkingSecGroupRule(sgID, m)
+		if err != nil {
+			return err
+		}
+		createOpts = append(createOpts, opts)
+	}
+
+	for _, id := range currentRules {
+		log.Printf("[DEBUG] Deleting rule %s of vkcs_networking_secgroup %s", id, sgID)
+		if err := irules.Delete(client, id).ExtractErr(); err != nil && !errutil.IsNotFound(err) {
+			return fmt.Errorf("error deleting rule %s of vkcs_networking_secgroup %s: %s", id, sgID, err)
+		}
+	}
+
+	if len(createOpts) > 0 {
+		log.Printf("[DEBUG] vkcs_networking_secgroup %s rules create options: %#v", sgID, createOpts)
+		if _, err := irules.CreateBulk(client, createOpts).Extract(); err != nil {
+			return fmt.Errorf("error creating rules of vkcs_networking_secgroup %s: %s", sgID, err)
+		}
+	}
+
+	return nil
+}
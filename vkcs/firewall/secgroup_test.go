@@ -0,0 +1,78 @@
+package firewall
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/rules"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func testNetworkingSecGroupRule(direction, protocol string, port int, remoteIPPrefix string) map[string]interface{} {
+	return map[string]interface{}{
+		"id":               "",
+		"direction":        direction,
+		"ethertype":        "",
+		"protocol":         protocol,
+		"port_range_min":   port,
+		"port_range_max":   port,
+		"remote_ip_prefix": remoteIPPrefix,
+		"remote_group_id":  "",
+		"description":      "",
+	}
+}
+
+func TestNetworkingSecGroupRuleHash(t *testing.T) {
+	configured := testNetworkingSecGroupRule("ingress", "TCP", 22, "10.0.0.1/8")
+
+	fromAPI := testNetworkingSecGroupRule("ingress", "tcp", 22, "10.0.0.0/8")
+	fromAPI["id"] = "0a1d2f3c-8b1e-4f0e-9c1a-5b6f7e8d9c0b"
+	fromAPI["ethertype"] = "IPv4"
+
+	assert.Equal(t, networkingSecGroupRuleHash(configured), networkingSecGroupRuleHash(fromAPI))
+	assert.NotEqual(t, networkingSecGroupRuleHash(configured), networkingSecGroupRuleHash(testNetworkingSecGroupRule("egress", "tcp", 22, "10.0.0.0/8")))
+	assert.NotEqual(t, networkingSecGroupRuleHash(configured), networkingSecGroupRuleHash(testNetworkingSecGroupRule("ingress", "tcp", 23, "10.0.0.0/8")))
+}
+
+func TestExpandNetworkingSecGroupRule(t *testing.T) {
+	opts, err := expandNetworkingSecGroupRule("sg", testNetworkingSecGroupRule("ingress", "tcp", 443, "0.0.0.0/0"))
+	assert.NoError(t, err)
+	assert.Equal(t, rules.CreateOpts{
+		SecGroupID:     "sg",
+		Direction:      rules.DirIngress,
+		EtherType:      rules.EtherType4,
+		Protocol:       rules.ProtocolTCP,
+		PortRangeMin:   443,
+		PortRangeMax:   443,
+		RemoteIPPrefix: "0.0.0.0/0",
+	}, opts)
+
+	_, err = expandNetworkingSecGroupRule("sg", testNetworkingSecGroupRule("ingress", "", 443, ""))
+	assert.Error(t, err)
+
+	rule := testNetworkingSecGroupRule("ingress", "tcp", 443, "0.0.0.0/0")
+	rule["remote_group_id"] = "sg"
+	_, err = expandNetworkingSecGroupRule("sg", rule)
+	assert.Error(t, err)
+}
+
+func TestFlattenNetworkingSecGroupRulesKeepsExisting(t *testing.T) {
+	existing := schema.NewSet(networkingSecGroupRuleHash, []interface{}{
+		testNetworkingSecGroupRule("ingress", "TCP", 22, "10.0.0.0/8"),
+	})
+	sgRules := []rules.SecGroupRule{
+		{ID: "rule-1", Direction: "ingress", EtherType: "IPv4", Protocol: "tcp", PortRangeMin: 22, PortRangeMax: 22, RemoteIPPrefix: "10.0.0.0/8"},
+		{ID: "rule-2", Direction: "egress", EtherType: "IPv4"},
+	}
+
+	actual := flattenNetworkingSecGroupRules(sgRules, existing)
+
+	assert.Len(t, actual, 2)
+	kept := actual[0].(map[string]interface{})
+	assert.Equal(t, "rule-1", kept["id"])
+	assert.Equal(t, "TCP", kept["protocol"])
+	assert.Equal(t, "", kept["ethertype"])
+	extra := actual[1].(map[string]interface{})
+	assert.Equal(t, "rule-2", extra["id"])
+	assert.Equal(t, "egress", extra["direction"])
+}
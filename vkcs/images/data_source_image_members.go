@@ -0,0 +1,167 @@
+package images
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/members"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/clients"
+)
+
+var (
+	_ datasource.DataSource              = &ImageMembersDataSource{}
+	_ datasource.DataSourceWithConfigure = &ImageMembersDataSource{}
+)
+
+func NewImageMembersDataSource() datasource.DataSource {
+	return &ImageMembersDataSource{}
+}
+
+type ImageMembersDataSource struct {
+	config clients.Config
+}
+
+type ImageMembersDataSourceModel struct {
+	ID     types.String `tfsdk:"id"`
+	Region types.String `tfsdk:"region"`
+
+	ImageID types.String       `tfsdk:"image_id"`
+	Members []ImageMemberModel `tfsdk:"members"`
+}
+
+type ImageMemberModel struct {
+	MemberID  types.String `tfsdk:"member_id"`
+	Status    types.String `tfsdk:"status"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+func (d *ImageMembersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "vkcs_images_image_members"
+}
+
+func (d *ImageMembersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the data source",
+			},
+
+			"region": schema.StringAttribute{
+				Computed:    true,
+				Optional:    true,
+				Description: "The region in which to obtain the Images client. If omitted, the `region` argument of the provider is used.",
+			},
+
+			"image_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the image.",
+			},
+
+			"members": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Members of the image.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"member_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the project the image is shared with.",
+						},
+
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "The status of the member.",
+						},
+
+						"created_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "The date the member was added.",
+						},
+
+						"updated_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "The date the member was last updated.",
+						},
+					},
+				},
+			},
+		},
+		Description: "Use this data source to get the list of projects a VKCS image is shared with.",
+	}
+}
+
+func (d *ImageMembersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.config = req.ProviderData.(clients.Config)
+}
+
+func (d *ImageMembersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ImageMembersDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	region := data.Region.ValueString()
+	if region == "" {
+		region = d.config.GetRegion()
+	}
+
+	client, err := d.config.ImageV2Client(region)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating VKCS image client", err.Error())
+		return
+	}
+
+	imageID := data.ImageID.ValueString()
+
+	tflog.Debug(ctx, "Calling Images API to list image members", map[string]interface{}{"image_id": imageID})
+
+	allPages, err := members.List(client, imageID).AllPages()
+	if err != nil {
+		resp.Diagnostics.AddError("Error calling VKCS Images API", err.Error())
+		return
+	}
+
+	allMembers, err := members.ExtractMembers(allPages)
+	if err != nil {
+		resp.Diagnostics.AddError("Error processing VKCS Images API response", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Called Images API to list image members", map[string]interface{}{"members": fmt.Sprintf("%#v", allMembers)})
+
+	flattenedMembers := flattenImageMembers(allMembers)
+	sort.SliceStable(flattenedMembers, func(i, j int) bool {
+		return flattenedMembers[i].MemberID.ValueString() < flattenedMembers[j].MemberID.ValueString()
+	})
+
+	data.ID = types.StringValue(imageID)
+	data.Region = types.StringValue(region)
+	data.Members = flattenedMembers
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func flattenImageMembers(allMembers []members.Member) []ImageMemberModel {
+	r := make([]ImageMemberModel, 0, len(allMembers))
+	for _, m := range allMembers {
+		r = append(r, ImageMemberModel{
+			MemberID:  types.StringValue(m.MemberID),
+			Status:    types.StringValue(m.Status),
+			CreatedAt: types.StringValue(m.CreatedAt.Format(time.RFC3339)),
+			UpdatedAt: types.StringValue(m.UpdatedAt.Format(time.RFC3339)),
+		})
+	}
+	return r
+}
@@ -6,7 +6,6 @@ import (
 	"compress/gzip"
 	"context"
 	"crypto/md5"
-	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
@@ -20,14 +19,21 @@ import (
 
 	"github.com/gofrs/flock"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/klauspost/compress/zstd"
 	"github.com/ulikunitz/xz"
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/images"
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/tasks"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	iimageimport "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/images/v2/imageimport"
 	iimages "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/images/v2/images"
 )
 
+// imageImportTaskType is the type of tasks created by the image service
+// interoperable image import.
+const imageImportTaskType = "api_image_import"
+
 var publicCloudDomains = []string{
 	"mcs.mail.ru",
 	"cloud.vk.ru",
@@ -187,54 +193,32 @@ func resourceImagesImageVisibilityFromString(v string) images.ImageVisibility {
 	return ""
 }
 
-func fileMD5Checksum(f *os.File) (string, error) {
-	hash := md5.New()
-	if _, err := io.Copy(hash, f); err != nil {
-		return "", err
-	}
-	return hex.EncodeToString(hash.Sum(nil)), nil
-}
-
-func resourceImagesImageFileProps(filename string) (int64, string, error) {
-	var filesize int64
-	var filechecksum string
-
-	file, err := os.Open(filename)
-	if err != nil {
-		return -1, "", fmt.Errorf("error opening file for Image: %s", err)
-	}
-	defer file.Close()
-
-	fstat, err := file.Stat()
-	if err != nil {
-		return -1, "", fmt.Errorf("error reading image file %q: %s", file.Name(), err)
-	}
-
-	filesize = fstat.Size()
-	filechecksum, err = fileMD5Checksum(file)
-	if err != nil {
-		return -1, "", fmt.Errorf("error computing image file %q checksum: %s", file.Name(), err)
-	}
-
-	return filesize, filechecksum, nil
-}
-
-func resourceImagesImageFile(client *gophercloud.ServiceClient, d *schema.ResourceData) (string, error) {
+// resourceImagesImageFile returns the local file of the image, downloading
+// image_source_url to the cache if needed. Content-Type of the response is
+// returned when the image is downloaded.
+func resourceImagesImageFile(client *gophercloud.ServiceClient, d *schema.ResourceData) (string, string, error) {
 	if filename := d.Get("local_file_path").(string); filename != "" {
-		return filename, nil
+		return filename, "", nil
 	}
 
 	furl := d.Get("image_source_url").(string)
 	if furl == "" {
-		return "", fmt.Errorf("error in config. no file specified")
+		return "", "", fmt.Errorf("error in config. no file specified")
 	}
 
 	dir := d.Get("image_cache_path").(string)
 	if err := os.MkdirAll(dir, 0700); err != nil {
-		return "", fmt.Errorf("unable to create dir %s: %s", dir, err)
+		return "", "", fmt.Errorf("unable to create dir %s: %s", dir, err)
+	}
+
+	// A compressed or archived source is cached as is and unpacked while
+	// uploading, so it is stored apart from the plain images cached before.
+	ext := "img"
+	if d.Get("compression_format").(string) != "" || d.Get("archiving_format").(string) != "" {
+		ext = "src"
 	}
 
-	filename := filepath.Join(dir, fmt.Sprintf("%x.img", md5.Sum([]byte(furl))))
+	filename := filepath.Join(dir, fmt.Sprintf("%x.%s", md5.Sum([]byte(furl)), ext))
 	delFile := func() {
 		if err := os.Remove(filename); err != nil {
 			log.Printf("[DEBUG] Failed to cleanup the %q file: %s", filename, err)
@@ -245,7 +229,7 @@ func resourceImagesImageFile(client *gophercloud.ServiceClient, d *schema.Resour
 	lock := flock.New(lockFilename)
 	err := lock.Lock()
 	if err != nil {
-		return "", fmt.Errorf("unable to create file lock on file %s: %s", lockFilename, err)
+		return "", "", fmt.Errorf("unable to create file lock on file %s: %s", lockFilename, err)
 	}
 	defer func() {
 		err := lock.Unlock()
@@ -256,7 +240,7 @@ func resourceImagesImageFile(client *gophercloud.ServiceClient, d *schema.Resour
 
 	info, err := os.Stat(filename)
 	if err != nil && !os.IsNotExist(err) {
-		return "", fmt.Errorf("error while trying to access file %q: %s", filename, err)
+		return "", "", fmt.Errorf("error while trying to access file %q: %s", filename, err)
 	}
 
 	// check if the file size is zero
@@ -264,7 +248,7 @@ func resourceImagesImageFile(client *gophercloud.ServiceClient, d *schema.Resour
 	if info != nil {
 		if info.Size() != 0 {
 			log.Printf("[DEBUG] File exists %s", filename)
-			return filename, nil
+			return filename, "", nil
 		}
 		// delete the zero size file
 		delFile()
@@ -273,7 +257,7 @@ func resourceImagesImageFile(client *gophercloud.ServiceClient, d *schema.Resour
 	log.Printf("[DEBUG] File doesn't exists %s. will download from %s", filename, furl)
 	file, err := os.Create(filename)
 	if err != nil {
-		return "", fmt.Errorf("error creating file %q: %s", filename, err)
+		return "", "", fmt.Errorf("error creating file %q: %s", filename, err)
 	}
 	defer file.Close()
 
@@ -281,7 +265,7 @@ func resourceImagesImageFile(client *gophercloud.ServiceClient, d *schema.Resour
 	request, err := http.NewRequest("GET", furl, nil)
 	if err != nil {
 		delFile()
-		return "", fmt.Errorf("error creating a new request: %s", err)
+		return "", "", fmt.Errorf("error creating a new request: %s", err)
 	}
 
 	username := d.Get("image_source_username").(string)
@@ -293,55 +277,23 @@ func resourceImagesImageFile(client *gophercloud.ServiceClient, d *schema.Resour
 	resp, err := httpClient.Do(request)
 	if err != nil {
 		delFile()
-		return "", fmt.Errorf("error downloading image from %q: %s", furl, err)
+		return "", "", fmt.Errorf("error downloading image from %q: %s", furl, err)
 	}
 
 	// check for credential error among other errors
 	if resp.StatusCode != http.StatusOK {
 		delFile()
-		return "", fmt.Errorf("error downloading image from %q, status code is %d", furl, resp.StatusCode)
+		return "", "", fmt.Errorf("error downloading image from %q, status code is %d", furl, resp.StatusCode)
 	}
 
 	defer resp.Body.Close()
-	reader := resp.Body
-
-	compressionFormat := d.Get("compression_format").(string)
-	if compressionFormat == compressionFormatAuto {
-		// If we're here "Content-Encoding" in not filled, we'll read
-		// "Content-Type" to select format
-		compressionFormat, err = getCompressionFormatFromContentType(resp.Header.Get("Content-Type"))
-		if err != nil {
-			delFile()
-			return "", fmt.Errorf("error decompressing image %q: %s", furl, err)
-		}
-	}
-	if compressionFormat != "" {
-		decompressReader, err := selectDecompressReader(reader, compressionFormat)
-		if err != nil {
-			delFile()
-			return "", fmt.Errorf("error decompressing image %q: %s", furl, err)
-		}
-		defer decompressReader.Close()
-		reader = decompressReader
-	}
 
-	archivingFormat := d.Get("archiving_format").(string)
-	if archivingFormat != "" {
-		unzipReader, err := selectUnzipReader(reader, archivingFormat)
-		if err != nil {
-			delFile()
-			return "", fmt.Errorf("error unzipping image %q: %s", furl, err)
-		}
-		defer unzipReader.Close()
-		reader = unzipReader
-	}
-
-	if _, err = io.Copy(file, reader); err != nil {
+	if _, err = io.Copy(file, resp.Body); err != nil {
 		delFile()
-		return "", fmt.Errorf("error downloading image %q to file %q: %s", furl, filename, err)
+		return "", "", fmt.Errorf("error downloading image %q to file %q: %s", furl, filename, err)
 	}
 
-	return filename, nil
+	return filename, resp.Header.Get("Content-Type"), nil
 }
 
 func selectDecompressReader(src io.Reader, format string) (io.ReadCloser, error) {
@@ -356,6 +308,12 @@ func selectDecompressReader(src io.Reader, format string) (io.ReadCloser, error)
 			return nil, err
 		}
 		return io.NopCloser(xzReader), nil
+	case compressionFormatZSTD:
+		zstdReader, err := zstd.NewReader(src)
+		if err != nil {
+			return nil, err
+		}
+		return zstdReader.IOReadCloser(), nil
 	}
 
 	return nil, fmt.Errorf("format %s is not supported", format)
@@ -369,6 +327,8 @@ func getCompressionFormatFromContentType(contentType string) (string, error) {
 		return compressionFormatBZIP2, nil
 	case "xz", "application/xz", "application/x-xz":
 		return compressionFormatXZ, nil
+	case "zstd", "application/zstd":
+		return compressionFormatZSTD, nil
 	}
 	return "", fmt.Errorf("content-type %s is not supported", contentType)
 }
@@ -408,6 +368,102 @@ func resourceImagesImageRefreshFunc(client *gophercloud.ServiceClient, id string
 	}
 }
 
+func resourceImagesImageImportRefreshFunc(client *gophercloud.ServiceClient, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		img, err := iimages.Get(client, id).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+
+		importTasks, err := iimageimport.ListTasks(client, id).Extract()
+		if err != nil {
+			// Image tasks are informational only, so the import is still
+			// tracked by the image status if they cannot be retrieved.
+			log.Printf("[DEBUG] Unable to retrieve import tasks of VKCS image %s: %s", id, err)
+		}
+
+		for _, task := range importTasks {
+			if task.Type != imageImportTaskType {
+				continue
+			}
+			log.Printf("[DEBUG] VKCS image %s import task %s status is: %s, %s", id, task.ID, task.Status, task.Message)
+			if task.Status == string(tasks.TaskStatusFailure) {
+				return img, "", fmt.Errorf("import task %s failed: %s", task.ID, task.Message)
+			}
+		}
+
+		if failed, ok := img.Properties["os_glance_failed_import"].(string); ok && failed != "" {
+			return img, "", fmt.Errorf("image import to stores %q failed", failed)
+		}
+
+		log.Printf("[DEBUG] VKCS image status is: %s", img.Status)
+
+		return img, string(img.Status), nil
+	}
+}
+
+// resourceImagesImageCheckImportMethod checks that the image service
+// supports the import method.
+func resourceImagesImageCheckImportMethod(client *gophercloud.ServiceClient, method string) error {
+	info, err := iimageimport.Get(client).Extract()
+	if err != nil {
+		return fmt.Errorf("error retrieving image import methods: %s", err)
+	}
+
+	for _, m := range info.ImportMethods.Value {
+		if m == method {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("import method %q is not supported by the image service, supported methods are: %s",
+		method, strings.Join(info.ImportMethods.Value, ", "))
+}
+
+// resourceImagesImageCheckImported checks that the image imported with
+// "web-download" import method is active and has its checksum calculated by
+// the image service. If expectedChecksum is set, it must match the checksum
+// of the image.
+func resourceImagesImageCheckImported(img *images.Image, expectedChecksum string) error {
+	if img.Status != images.ImageStatusActive {
+		return fmt.Errorf("image is in %q status, expected %q", img.Status, images.ImageStatusActive)
+	}
+
+	if expectedChecksum != "" {
+		if img.Checksum != expectedChecksum {
+			return fmt.Errorf("wrong checksum: got %q, expected %q", img.Checksum, expectedChecksum)
+		}
+		return nil
+	}
+
+	if hash, _ := img.Properties["os_hash_value"].(string); img.Checksum == "" && hash == "" {
+		return fmt.Errorf("the image service did not calculate checksum of the image")
+	}
+
+	return nil
+}
+
+func resourceImagesImageValidateImportMethod(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Get("import_method").(string) != importMethodWebDownload {
+		if diff.Get("image_source_checksum").(string) != "" {
+			return fmt.Errorf("\"image_source_checksum\" can only be used with \"web-download\" \"import_method\"")
+		}
+		return nil
+	}
+
+	if diff.Get("image_source_url").(string) == "" {
+		return fmt.Errorf("\"image_source_url\" must be set when \"import_method\" is \"web-download\"")
+	}
+
+	for _, k := range []string{"image_source_username", "image_source_password", "compression_format", "archiving_format"} {
+		if diff.Get(k).(string) != "" {
+			return fmt.Errorf("%q cannot be used when \"import_method\" is \"web-download\"", k)
+		}
+	}
+
+	return nil
+}
+
 func resourceImagesImageBuildTags(v []interface{}) []string {
 	tags := make([]string, len(v))
 	for i, tag := range v {
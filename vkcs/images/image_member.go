@@ -0,0 +1,23 @@
+package images
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/images"
+)
+
+var imageMemberStatuses = []string{
+	string(images.ImageMemberStatusAccepted),
+	string(images.ImageMemberStatusPending),
+	string(images.ImageMemberStatusRejected),
+}
+
+func ImagesImageMemberParseID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unable to determine image member ID from %q, expected format: <image_id>/<member_id>", id)
+	}
+
+	return parts[0], parts[1], nil
+}
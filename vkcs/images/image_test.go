@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/images"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -19,8 +20,21 @@ func TestResourceImagesImageFile(t *testing.T) {
 	client := new(gophercloud.ServiceClient)
 	client.ProviderClient = new(gophercloud.ProviderClient)
 
-	filename, err := resourceImagesImageFile(client, image)
+	filename, _, err := resourceImagesImageFile(client, image)
 
 	assert.Equal(t, nil, err)
 	assert.Equal(t, filepath.Join(imgCachePath, "e18f6225aa529b597b260081c6ecb1da.img"), filename)
 }
+
+func TestResourceImagesImageCheckImported(t *testing.T) {
+	active := &images.Image{Status: images.ImageStatusActive, Checksum: "abc"}
+	assert.NoError(t, resourceImagesImageCheckImported(active, ""))
+	assert.NoError(t, resourceImagesImageCheckImported(active, "abc"))
+	assert.Error(t, resourceImagesImageCheckImported(active, "def"))
+
+	withHash := &images.Image{Status: images.ImageStatusActive, Properties: map[string]interface{}{"os_hash_value": "abc"}}
+	assert.NoError(t, resourceImagesImageCheckImported(withHash, ""))
+
+	assert.Error(t, resourceImagesImageCheckImported(&images.Image{Status: images.ImageStatusActive}, ""))
+	assert.Error(t, resourceImagesImageCheckImported(&images.Image{Status: images.ImageStatusQueued, Checksum: "abc"}, ""))
+}
@@ -8,14 +8,16 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/clients"
 	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/util"
 
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/imageimport"
 	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/images"
-	iimagedata "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/images/v2/imagedata"
+	iimageimport "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/images/v2/imageimport"
 	iimages "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/images/v2/images"
 )
 
@@ -28,12 +30,21 @@ const (
 	compressionFormatBZIP2 = "bzip2"
 	compressionFormatGZIP  = "gzip"
 	compressionFormatXZ    = "xz"
+	compressionFormatZSTD  = "zstd"
 )
 
 const (
 	archivingFormatTAR = "tar"
 )
 
+const (
+	importMethodWebDownload  = string(imageimport.WebDownloadMethod)
+	importMethodGlanceDirect = string(imageimport.GlanceDirectMethod)
+)
+
+// imageStatusUploading is the status of an image with staged data.
+const imageStatusUploading = "uploading"
+
 func ResourceImagesImage() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceImagesImageCreate,
@@ -44,7 +55,10 @@ func ResourceImagesImage() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		CustomizeDiff: resourceImagesImageUpdateComputedAttributes,
+		CustomizeDiff: customdiff.Sequence(
+			resourceImagesImageUpdateComputedAttributes,
+			resourceImagesImageValidateImportMethod,
+		),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
@@ -87,7 +101,7 @@ func ResourceImagesImage() *schema.Resource {
 				DefaultFunc: func() (interface{}, error) {
 					return fmt.Sprintf("%s/.terraform/image_cache", os.Getenv("HOME")), nil
 				},
-				Description: "This is the directory where the images will be downloaded. Images will be stored with a filename corresponding to the url's md5 hash. Defaults to \"$HOME/.terraform/image_cache\"",
+				Description: "This is the directory where the images will be downloaded. Images will be stored with a filename corresponding to the url's md5 hash. Compressed and archived images are stored as is and unpacked while uploading. Defaults to \"$HOME/.terraform/image_cache\"",
 			},
 
 			"image_source_url": {
@@ -95,7 +109,27 @@ func ResourceImagesImage() *schema.Resource {
 				Optional:      true,
 				ForceNew:      true,
 				ConflictsWith: []string{"local_file_path"},
-				Description:   "This is the url of the raw image. The image will be downloaded in the `image_cache_path` before being uploaded to VKCS. Conflicts with `local_file_path`.",
+				Description:   "This is the url of the raw image. Unless `import_method` is \"web-download\", the image will be downloaded in the `image_cache_path` before being uploaded to VKCS. Conflicts with `local_file_path`.",
+			},
+
+			"image_source_checksum": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"local_file_path"},
+				Description: "The MD5 checksum of the image at `image_source_url`. Used to verify the image imported with \"web-download\" `import_method` unless `verify_checksum` is false. " +
+					"Without it, the image service downloads the image itself, so only presence of the checksum calculated by the image service is verified.",
+			},
+
+			"import_method": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					importMethodWebDownload, importMethodGlanceDirect,
+				}, false),
+				Description: "The method of the image service interoperable image import. If set to \"web-download\", the image service downloads `image_source_url` itself without using the `image_cache_path`. " +
+					"If set to \"glance-direct\", the image is staged and then imported by the image service. If omitted, the image is uploaded directly. Changing this creates a new Image.",
 			},
 
 			"image_source_username": {
@@ -189,9 +223,9 @@ func ResourceImagesImage() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 				ForceNew:    true,
-				Description: "The format of compressed image. Use this attribute to decompress image when downloading it from source. Must be one of \"auto\", \"bzip2\", \"gzip\", \"xz\". If set to \"auto\", response Content-Type header will be used to detect compression format.",
+				Description: "The format of compressed image. Use this attribute to decompress `image_source_url` or `local_file_path` image while uploading it. Must be one of \"auto\", \"bzip2\", \"gzip\", \"xz\", \"zstd\". If set to \"auto\", compression format is detected from Content-Type of the downloaded `image_source_url` or from the image data.",
 				ValidateFunc: validation.StringInSlice([]string{
-					compressionFormatAuto, compressionFormatBZIP2, compressionFormatGZIP, compressionFormatXZ,
+					compressionFormatAuto, compressionFormatBZIP2, compressionFormatGZIP, compressionFormatXZ, compressionFormatZSTD,
 				}, false),
 			},
 
@@ -199,7 +233,7 @@ func ResourceImagesImage() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ForceNew:     true,
-				Description:  "The format of archived image file. Use this to unzip image file when downloading an archive. Currently only \"tar\" format is supported.",
+				Description:  "The format of archived image file. Use this to unpack `image_source_url` or `local_file_path` archive while uploading it. Currently only \"tar\" format is supported.",
 				ValidateFunc: validation.StringInSlice([]string{archivingFormatTAR}, false),
 			},
 
@@ -303,39 +337,59 @@ func resourceImagesImageCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	var fileChecksum string
 
-	// variable declaration
-	var imgFilePath string
-	var fileSize int64
-	var imgFile *os.File
-
-	// downloading/getting image file props
-	imgFilePath, err = resourceImagesImageFile(imageClient, d)
-	if err != nil {
-		return diag.Errorf("Error opening file for Image: %s", err)
-	}
-	fileSize, fileChecksum, err = resourceImagesImageFileProps(imgFilePath)
-	if err != nil {
-		return diag.Errorf("Error getting file props: %s", err)
+	importMethod := d.Get("import_method").(string)
+	if importMethod != "" {
+		if err := resourceImagesImageCheckImportMethod(imageClient, importMethod); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
-	// upload
-	imgFile, err = os.Open(imgFilePath)
-	if err != nil {
-		return diag.Errorf("Error opening file %q: %s", imgFilePath, err)
-	}
-	defer imgFile.Close()
-	log.Printf("[WARN] Uploading image %s (%d bytes). This can be pretty long.", d.Id(), fileSize)
+	if importMethod == importMethodWebDownload {
+		// The image service downloads the image itself, so the checksum
+		// can only be compared with the one provided by the user.
+		fileChecksum = d.Get("image_source_checksum").(string)
+
+		importOpts := imageimport.CreateOpts{
+			Name: imageimport.WebDownloadMethod,
+			URI:  d.Get("image_source_url").(string),
+		}
+
+		log.Printf("[DEBUG] Importing image %s with options: %#v", d.Id(), importOpts)
+
+		if err := iimageimport.Create(imageClient, d.Id(), importOpts).ExtractErr(); err != nil {
+			return diag.Errorf("Error importing image %s: %s", d.Id(), err)
+		}
+	} else {
+		open, err := resourceImagesImageDataOpener(imageClient, d)
+		if err != nil {
+			return diag.Errorf("Error opening file for Image: %s", err)
+		}
 
-	res := iimagedata.Upload(imageClient, d.Id(), imgFile)
-	if res.Err != nil {
-		return diag.Errorf("Error while uploading file %q: %s", imgFilePath, res.Err)
+		stage := importMethod == importMethodGlanceDirect
+		fileChecksum, err = resourceImagesImageUploadData(ctx, imageClient, d.Id(), open, stage)
+		if err != nil {
+			return diag.Errorf("Error while uploading data of Image %s: %s", d.Id(), err)
+		}
+
+		if stage {
+			importOpts := imageimport.CreateOpts{
+				Name: imageimport.GlanceDirectMethod,
+			}
+			if err := iimageimport.Create(imageClient, d.Id(), importOpts).ExtractErr(); err != nil {
+				return diag.Errorf("Error importing image %s: %s", d.Id(), err)
+			}
+		}
 	}
 
 	// wait for active
+	refreshFunc := resourceImagesImageRefreshFunc(imageClient, d.Id())
+	if importMethod != "" {
+		refreshFunc = resourceImagesImageImportRefreshFunc(imageClient, d.Id())
+	}
 	stateConf := &retry.StateChangeConf{
-		Pending:    []string{string(images.ImageStatusQueued), string(images.ImageStatusSaving), string(images.ImageStatusImporting)},
+		Pending:    []string{string(images.ImageStatusQueued), string(images.ImageStatusSaving), string(images.ImageStatusImporting), imageStatusUploading},
 		Target:     []string{string(images.ImageStatusActive)},
-		Refresh:    resourceImagesImageRefreshFunc(imageClient, d.Id()),
+		Refresh:    refreshFunc,
 		Timeout:    d.Timeout(schema.TimeoutCreate),
 		Delay:      10 * time.Second,
 		MinTimeout: 3 * time.Second,
@@ -350,8 +404,13 @@ func resourceImagesImageCreate(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(util.CheckDeleted(d, err, "image"))
 	}
 
-	if v, ok := d.GetOk("verify_checksum"); !ok || (ok && v.(bool)) {
-		if img.Checksum != fileChecksum {
+	// GetOk does not tell an explicit false from an unset value.
+	if v := d.GetRawConfig().GetAttr("verify_checksum"); v.IsNull() || v.True() {
+		if importMethod == importMethodWebDownload {
+			if err := resourceImagesImageCheckImported(img, fileChecksum); err != nil {
+				return diag.Errorf("Error verifying imported image %s: %s", d.Id(), err)
+			}
+		} else if fileChecksum != "" && img.Checksum != fileChecksum {
 			return diag.Errorf("Error wrong checksum: got %q, expected %q", img.Checksum, fileChecksum)
 		}
 	}
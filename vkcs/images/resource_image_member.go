@@ -0,0 +1,151 @@
+package images
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/clients"
+	imembers "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/images/v2/members"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/util"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/util/errutil"
+)
+
+func ResourceImagesImageMember() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceImagesImageMemberCreate,
+		ReadContext:   resourceImagesImageMemberRead,
+		DeleteContext: resourceImagesImageMemberDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The region in which to obtain the Image client. If omitted, the `region` argument of the provider is used. Changing this creates a new member.",
+			},
+
+			"image_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the image to share. The image must have `shared` visibility. Changing this creates a new member.",
+			},
+
+			"member_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the project to share the image with. Changing this creates a new member.",
+			},
+
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the member. The member sets it to `accepted` or `rejected`, see `vkcs_images_image_member_accept`.",
+			},
+
+			"schema": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The path to the JSON-schema that represent the member.",
+			},
+
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the member was added.",
+			},
+
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the member was last updated.",
+			},
+		},
+		Description: "Manages a member of a shared VKCS image. The member is a project the image is shared with.",
+	}
+}
+
+func resourceImagesImageMemberCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(clients.Config)
+	imageClient, err := config.ImageV2Client(util.GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating VKCS image client: %s", err)
+	}
+
+	imageID := d.Get("image_id").(string)
+	memberID := d.Get("member_id").(string)
+
+	log.Printf("[DEBUG] Adding member %s to image %s", memberID, imageID)
+
+	member, err := imembers.Create(imageClient, imageID, memberID).Extract()
+	if err != nil {
+		return diag.Errorf("Error adding member %s to image %s: %s", memberID, imageID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", member.ImageID, member.MemberID))
+
+	return resourceImagesImageMemberRead(ctx, d, meta)
+}
+
+func resourceImagesImageMemberRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(clients.Config)
+	imageClient, err := config.ImageV2Client(util.GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating VKCS image client: %s", err)
+	}
+
+	imageID, memberID, err := ImagesImageMemberParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	member, err := imembers.Get(imageClient, imageID, memberID).Extract()
+	if err != nil {
+		return diag.FromErr(util.CheckDeleted(d, err, "image member"))
+	}
+
+	log.Printf("[DEBUG] Retrieved image member %s: %#v", d.Id(), member)
+
+	d.Set("image_id", member.ImageID)
+	d.Set("member_id", member.MemberID)
+	d.Set("status", member.Status)
+	d.Set("schema", member.Schema)
+	d.Set("created_at", member.CreatedAt.Format(time.RFC3339))
+	d.Set("updated_at", member.UpdatedAt.Format(time.RFC3339))
+	d.Set("region", util.GetRegion(d, config))
+
+	return nil
+}
+
+func resourceImagesImageMemberDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(clients.Config)
+	imageClient, err := config.ImageV2Client(util.GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating VKCS image client: %s", err)
+	}
+
+	imageID, memberID, err := ImagesImageMemberParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Removing member %s from image %s", memberID, imageID)
+
+	if err := imembers.Delete(imageClient, imageID, memberID).ExtractErr(); err != nil {
+		if errutil.IsNotFound(err) {
+			return nil
+		}
+		return diag.Errorf("Error removing member %s from image %s: %s", memberID, imageID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,178 @@
+package images
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/images"
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/members"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/clients"
+	imembers "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/images/v2/members"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/util"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/util/errutil"
+)
+
+func ResourceImagesImageMemberAccept() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceImagesImageMemberAcceptCreate,
+		ReadContext:   resourceImagesImageMemberAcceptRead,
+		UpdateContext: resourceImagesImageMemberAcceptUpdate,
+		DeleteContext: resourceImagesImageMemberAcceptDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The region in which to obtain the Image client. If omitted, the `region` argument of the provider is used. Changing this creates a new resource.",
+			},
+
+			"image_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the image shared with the current project. Changing this creates a new resource.",
+			},
+
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      string(images.ImageMemberStatusAccepted),
+				ValidateFunc: validation.StringInSlice(imageMemberStatuses, false),
+				Description:  "The status of the membership. Must be one of \"accepted\", \"rejected\" or \"pending\". Defaults to \"accepted\".",
+			},
+
+			"member_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the current project which is the member of the image.",
+			},
+
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the member was added.",
+			},
+
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the member was last updated.",
+			},
+		},
+		Description: "Manages the status of the current project membership in a shared VKCS image. " +
+			"Use it in the project the image is shared with to accept or reject the image.\n" +
+			"_note_ Deleting the resource sets the membership status back to \"pending\".",
+	}
+}
+
+func resourceImagesImageMemberAcceptCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(clients.Config)
+	imageClient, err := config.ImageV2Client(util.GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating VKCS image client: %s", err)
+	}
+
+	imageID := d.Get("image_id").(string)
+	memberID := config.GetProjectID()
+	status := d.Get("status").(string)
+
+	log.Printf("[DEBUG] Setting status of member %s of image %s to %s", memberID, imageID, status)
+
+	member, err := imembers.Update(imageClient, imageID, memberID, members.UpdateOpts{Status: status}).Extract()
+	if err != nil {
+		return diag.Errorf("Error setting status of member %s of image %s: %s", memberID, imageID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", member.ImageID, member.MemberID))
+
+	return resourceImagesImageMemberAcceptRead(ctx, d, meta)
+}
+
+func resourceImagesImageMemberAcceptRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(clients.Config)
+	imageClient, err := config.ImageV2Client(util.GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating VKCS image client: %s", err)
+	}
+
+	imageID, memberID, err := ImagesImageMemberParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	member, err := imembers.Get(imageClient, imageID, memberID).Extract()
+	if err != nil {
+		return diag.FromErr(util.CheckDeleted(d, err, "image member"))
+	}
+
+	log.Printf("[DEBUG] Retrieved image member %s: %#v", d.Id(), member)
+
+	d.Set("image_id", member.ImageID)
+	d.Set("member_id", member.MemberID)
+	d.Set("status", member.Status)
+	d.Set("created_at", member.CreatedAt.Format(time.RFC3339))
+	d.Set("updated_at", member.UpdatedAt.Format(time.RFC3339))
+	d.Set("region", util.GetRegion(d, config))
+
+	return nil
+}
+
+func resourceImagesImageMemberAcceptUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(clients.Config)
+	imageClient, err := config.ImageV2Client(util.GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating VKCS image client: %s", err)
+	}
+
+	imageID, memberID, err := ImagesImageMemberParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("status") {
+		status := d.Get("status").(string)
+		log.Printf("[DEBUG] Setting status of member %s of image %s to %s", memberID, imageID, status)
+
+		_, err := imembers.Update(imageClient, imageID, memberID, members.UpdateOpts{Status: status}).Extract()
+		if err != nil {
+			return diag.Errorf("Error setting status of member %s of image %s: %s", memberID, imageID, err)
+		}
+	}
+
+	return resourceImagesImageMemberAcceptRead(ctx, d, meta)
+}
+
+func resourceImagesImageMemberAcceptDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(clients.Config)
+	imageClient, err := config.ImageV2Client(util.GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating VKCS image client: %s", err)
+	}
+
+	imageID, memberID, err := ImagesImageMemberParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Setting status of member %s of image %s back to %s", memberID, imageID, images.ImageMemberStatusPending)
+
+	updateOpts := members.UpdateOpts{Status: string(images.ImageMemberStatusPending)}
+	if _, err := imembers.Update(imageClient, imageID, memberID, updateOpts).Extract(); err != nil {
+		if errutil.IsNotFound(err) {
+			return nil
+		}
+		return diag.Errorf("Error setting status of member %s of image %s: %s", memberID, imageID, err)
+	}
+
+	return nil
+}
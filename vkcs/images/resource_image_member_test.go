@@ -0,0 +1,163 @@
+package images_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/images"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/acctest"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/clients"
+	imembers "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/images/v2/members"
+)
+
+func TestImagesImageMemberParseID(t *testing.T) {
+	imageID, memberID, err := images.ImagesImageMemberParseID("foo/bar")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if imageID != "foo" {
+		t.Fatalf("Image IDs differ. Want foo, but got %s", imageID)
+	}
+
+	if memberID != "bar" {
+		t.Fatalf("Member IDs differ. Want bar, but got %s", memberID)
+	}
+
+	if _, _, err := images.ImagesImageMemberParseID("foo"); err == nil {
+		t.Fatal("Expected an error for an ID without a member part")
+	}
+}
+
+func TestAccImagesImageMember_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: acctest.AccTestProviders,
+		CheckDestroy:      testAccCheckImagesImageMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImagesImageMemberBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagesImageMemberExists("vkcs_images_image_member.member_1"),
+					resource.TestCheckResourceAttr(
+						"vkcs_images_image_member.member_1", "status", "pending"),
+				),
+			},
+			{
+				ResourceName:      "vkcs_images_image_member.member_1",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccImagesImageMembersDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.AccTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImagesImageMemberBasic,
+			},
+			{
+				Config: acctest.AccTestRenderConfig(testAccImagesImageMembersDataSourceBasic, map[string]string{"TestAccImagesImageMemberBasic": testAccImagesImageMemberBasic}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vkcs_images_image_members.members", "members.#", "1"),
+					resource.TestCheckResourceAttrPair("data.vkcs_images_image_members.members", "members.0.member_id",
+						"vkcs_images_image_member.member_1", "member_id"),
+					resource.TestCheckResourceAttr("data.vkcs_images_image_members.members", "members.0.status", "pending"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckImagesImageMemberDestroy(s *terraform.State) error {
+	config := acctest.AccTestProvider.Meta().(clients.Config)
+	imageClient, err := config.ImageV2Client(acctest.OsRegionName)
+	if err != nil {
+		return fmt.Errorf("Error creating VKCS Image: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vkcs_images_image_member" {
+			continue
+		}
+
+		imageID, memberID, err := images.ImagesImageMemberParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = imembers.Get(imageClient, imageID, memberID).Extract()
+		if err == nil {
+			return fmt.Errorf("Image member still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckImagesImageMemberExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := acctest.AccTestProvider.Meta().(clients.Config)
+		imageClient, err := config.ImageV2Client(acctest.OsRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating VKCS Image: %s", err)
+		}
+
+		imageID, memberID, err := images.ImagesImageMemberParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		found, err := imembers.Get(imageClient, imageID, memberID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.MemberID != memberID {
+			return fmt.Errorf("Image member not found")
+		}
+
+		return nil
+	}
+}
+
+const testAccImagesImageMemberBasic = `
+resource "vkcs_images_image" "image_1" {
+  name             = "Cirros TerraformAccTest"
+  image_source_url = "http://download.cirros-cloud.net/0.6.2/cirros-0.6.2-x86_64-disk.img"
+  container_format = "bare"
+  disk_format      = "raw"
+  visibility       = "shared"
+
+  timeouts {
+    create = "10m"
+  }
+}
+
+resource "vkcs_images_image_member" "member_1" {
+  image_id  = vkcs_images_image.image_1.id
+  member_id = "b5d4e6d9a6f94b43a6a3b6bd2fa7c7e1"
+}
+`
+
+const testAccImagesImageMembersDataSourceBasic = `
+{{.TestAccImagesImageMemberBasic}}
+
+data "vkcs_images_image_members" "members" {
+  image_id = vkcs_images_image_member.member_1.image_id
+}
+`
@@ -38,6 +38,27 @@ func TestAccImagesImage_basic(t *testing.T) {
 	})
 }
 
+func TestAccImagesImage_webDownload(t *testing.T) {
+	var image images.Image
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: acctest.AccTestProviders,
+		CheckDestroy:      testAccCheckImagesImageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImagesImageWebDownload,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagesImageExists("vkcs_images_image.image_1", &image),
+					resource.TestCheckResourceAttr(
+						"vkcs_images_image.image_1", "import_method", "web-download"),
+					resource.TestCheckResourceAttr(
+						"vkcs_images_image.image_1", "status", "active"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccImagesImage_name(t *testing.T) {
 	var image images.Image
 
@@ -299,6 +320,20 @@ resource "vkcs_images_image" "image_1" {
 }
 `
 
+const testAccImagesImageWebDownload = `
+resource "vkcs_images_image" "image_1" {
+  name             = "Cirros TerraformAccTest"
+  image_source_url = "http://download.cirros-cloud.net/0.6.2/cirros-0.6.2-x86_64-disk.img"
+  import_method    = "web-download"
+  container_format = "bare"
+  disk_format      = "raw"
+
+  timeouts {
+    create = "10m"
+  }
+}
+`
+
 const testAccImagesImageName1 = `
 resource "vkcs_images_image" "image_1" {
   name               = "Centos TerraformAccTest"
@@ -0,0 +1,330 @@
+package images
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/images"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	iimagedata "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/images/v2/imagedata"
+	iimages "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/images/v2/images"
+)
+
+const (
+	imageUploadAttempts      = 3
+	imageUploadRetryDelay    = 10 * time.Second
+	imageUploadChunkSize     = 4 * 1024 * 1024
+	imageUploadChunksBuffer  = 4
+	imageUploadProgressBytes = 1024 * 1024 * 1024
+)
+
+var compressionFormatMagics = []struct {
+	format string
+	magic  []byte
+}{
+	{compressionFormatGZIP, []byte{0x1f, 0x8b}},
+	{compressionFormatBZIP2, []byte("BZh")},
+	{compressionFormatXZ, []byte{0xfd, '7', 'z', 'X', 'Z', 0x00}},
+	{compressionFormatZSTD, []byte{0x28, 0xb5, 0x2f, 0xfd}},
+}
+
+// imageDataOpener opens a new stream of the image data. It is called for
+// every upload attempt, so interrupted uploads are retried from the start of
+// the local file or the cached source.
+type imageDataOpener func() (io.ReadCloser, error)
+
+// resourceImagesImageDataOpener returns an opener of the image data which
+// unpacks the local file or the cached source on the fly according to
+// compression_format and archiving_format.
+func resourceImagesImageDataOpener(client *gophercloud.ServiceClient, d *schema.ResourceData) (imageDataOpener, error) {
+	filename, contentType, err := resourceImagesImageFile(client, d)
+	if err != nil {
+		return nil, err
+	}
+
+	compressionFormat := d.Get("compression_format").(string)
+	archivingFormat := d.Get("archiving_format").(string)
+
+	// Content-Type of a downloaded image takes precedence over detection of
+	// the format from the image data.
+	if compressionFormat == compressionFormatAuto && contentType != "" {
+		if format, err := getCompressionFormatFromContentType(contentType); err == nil {
+			compressionFormat = format
+		} else {
+			log.Printf("[DEBUG] Detecting compression format of %q from the image data: %s", filename, err)
+		}
+	}
+
+	return func() (io.ReadCloser, error) {
+		file, err := os.Open(filename)
+		if err != nil {
+			return nil, fmt.Errorf("error opening file %q: %s", filename, err)
+		}
+
+		rc, err := unpackImageData(file, compressionFormat, archivingFormat)
+		if err != nil {
+			file.Close()
+			return nil, fmt.Errorf("error unpacking file %q: %s", filename, err)
+		}
+
+		return rc, nil
+	}, nil
+}
+
+// unpackImageData wraps src with readers that decompress and unarchive the
+// image data. Closing the result closes src as well.
+func unpackImageData(src io.ReadCloser, compressionFormat, archivingFormat string) (io.ReadCloser, error) {
+	closers := []io.Closer{src}
+	var reader io.Reader = src
+
+	if compressionFormat == compressionFormatAuto {
+		buffered := bufio.NewReader(src)
+		format, err := detectCompressionFormat(buffered)
+		if err != nil {
+			return nil, err
+		}
+		compressionFormat = format
+		reader = buffered
+	}
+
+	if compressionFormat != "" {
+		decompressReader, err := selectDecompressReader(reader, compressionFormat)
+		if err != nil {
+			return nil, err
+		}
+		closers = append([]io.Closer{decompressReader}, closers...)
+		reader = decompressReader
+	}
+
+	if archivingFormat != "" {
+		unzipReader, err := selectUnzipReader(reader, archivingFormat)
+		if err != nil {
+			return nil, err
+		}
+		closers = append([]io.Closer{unzipReader}, closers...)
+		reader = unzipReader
+	}
+
+	return &multiCloseReader{Reader: reader, closers: closers}, nil
+}
+
+// detectCompressionFormat detects the compression format by the magic bytes
+// at the beginning of the data.
+func detectCompressionFormat(r *bufio.Reader) (string, error) {
+	header, err := r.Peek(6)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", err
+	}
+
+	for _, m := range compressionFormatMagics {
+		if bytes.HasPrefix(header, m.magic) {
+			return m.format, nil
+		}
+	}
+
+	return "", fmt.Errorf("unable to detect compression format")
+}
+
+type multiCloseReader struct {
+	io.Reader
+	closers []io.Closer
+}
+
+func (r *multiCloseReader) Close() error {
+	var errs []error
+	for _, c := range r.closers {
+		if err := c.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// resourceImagesImageUploadData uploads the image data, or stages it if
+// stage is true, and returns the MD5 checksum of the uploaded data. The
+// upload is retried while the image remains queued after a failed attempt.
+func resourceImagesImageUploadData(ctx context.Context, client *gophercloud.ServiceClient, id string, open imageDataOpener, stage bool) (string, error) {
+	for attempt := 1; ; attempt++ {
+		checksum, err := resourceImagesImageUploadAttempt(client, id, open, stage)
+		if err == nil {
+			return checksum, nil
+		}
+
+		if attempt >= imageUploadAttempts {
+			return "", err
+		}
+
+		img, getErr := iimages.Get(client, id).Extract()
+		if getErr != nil || img.Status != images.ImageStatusQueued {
+			return "", err
+		}
+
+		log.Printf("[WARN] Upload of image %s data failed (attempt %d of %d), retrying: %s", id, attempt, imageUploadAttempts, err)
+
+		select {
+		case <-ctx.Done():
+			return "", err
+		case <-time.After(imageUploadRetryDelay):
+		}
+	}
+}
+
+func resourceImagesImageUploadAttempt(client *gophercloud.ServiceClient, id string, open imageDataOpener, stage bool) (string, error) {
+	src, err := open()
+	if err != nil {
+		return "", err
+	}
+	defer src.Close()
+
+	progress := &imageUploadProgress{imageID: id, hash: md5.New()}
+	// Unpacking runs in parallel with the upload, so the upload does not wait
+	// for the next chunk of data to be decompressed.
+	pipe := newChunkPipe(io.TeeReader(src, progress))
+	defer pipe.Close()
+
+	log.Printf("[WARN] Uploading image %s. This can be pretty long.", id)
+
+	uploadClient := newImageUploadClient(client)
+	if stage {
+		err = iimagedata.Stage(uploadClient, id, pipe).Err
+	} else {
+		err = iimagedata.Upload(uploadClient, id, pipe).Err
+	}
+	if err != nil {
+		return "", fmt.Errorf("error uploading image data: %s", err)
+	}
+
+	// Wait for the source reading to stop before using the progress.
+	pipe.Close()
+	if err := pipe.Err(); err != nil {
+		return "", fmt.Errorf("error reading image data: %s", err)
+	}
+
+	log.Printf("[DEBUG] Uploaded %d bytes of image %s", progress.written, id)
+
+	return hex.EncodeToString(progress.hash.Sum(nil)), nil
+}
+
+// newImageUploadClient returns a copy of client which does not reauthenticate.
+// The image data is a stream which can not be rewound, so a request repeated
+// after reauthentication would send the rest of the data only. The failed
+// attempt is retried with a new stream instead, and the token is renewed by
+// the original client while checking the image status.
+func newImageUploadClient(client *gophercloud.ServiceClient) *gophercloud.ServiceClient {
+	providerClient := *client.ProviderClient
+	providerClient.ReauthFunc = nil
+
+	uploadClient := *client
+	uploadClient.ProviderClient = &providerClient
+	return &uploadClient
+}
+
+// imageUploadProgress calculates the checksum of the uploaded data and logs
+// the upload progress.
+type imageUploadProgress struct {
+	imageID string
+	hash    hash.Hash
+	written int64
+	logged  int64
+}
+
+func (p *imageUploadProgress) Write(b []byte) (int, error) {
+	n, _ := p.hash.Write(b)
+	p.written += int64(n)
+	if p.written-p.logged >= imageUploadProgressBytes {
+		p.logged = p.written
+		log.Printf("[DEBUG] Uploaded %d MiB of image %s", p.written/1024/1024, p.imageID)
+	}
+	return n, nil
+}
+
+// chunkPipe reads src in a separate goroutine and buffers several chunks of
+// data ahead of the reader.
+type chunkPipe struct {
+	chunks    chan []byte
+	done      chan struct{}
+	finished  chan struct{}
+	closeOnce sync.Once
+	current   []byte
+	mu        sync.Mutex
+	err       error
+}
+
+func newChunkPipe(src io.Reader) *chunkPipe {
+	p := &chunkPipe{
+		chunks:   make(chan []byte, imageUploadChunksBuffer),
+		done:     make(chan struct{}),
+		finished: make(chan struct{}),
+	}
+
+	go func() {
+		defer close(p.finished)
+		defer close(p.chunks)
+		for {
+			buf := make([]byte, imageUploadChunkSize)
+			n, err := io.ReadFull(src, buf)
+			if n > 0 {
+				select {
+				case p.chunks <- buf[:n]:
+				case <-p.done:
+					return
+				}
+			}
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				return
+			}
+			if err != nil {
+				p.mu.Lock()
+				p.err = err
+				p.mu.Unlock()
+				return
+			}
+		}
+	}()
+
+	return p
+}
+
+func (p *chunkPipe) Read(b []byte) (int, error) {
+	if len(p.current) == 0 {
+		chunk, ok := <-p.chunks
+		if !ok {
+			if err := p.Err(); err != nil {
+				return 0, err
+			}
+			return 0, io.EOF
+		}
+		p.current = chunk
+	}
+
+	n := copy(b, p.current)
+	p.current = p.current[n:]
+	return n, nil
+}
+
+// Err returns an error occurred while reading the source.
+func (p *chunkPipe) Err() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.err
+}
+
+// Close stops reading the source and waits for the reading goroutine to
+// exit, so the source can be safely closed afterwards.
+func (p *chunkPipe) Close() error {
+	p.closeOnce.Do(func() { close(p.done) })
+	<-p.finished
+	return nil
+}
@@ -0,0 +1,167 @@
+package images
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	thclient "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnpackImageData(t *testing.T) {
+	data := bytes.Repeat([]byte("image data "), 1024*1024)
+
+	var gzipped bytes.Buffer
+	gw := gzip.NewWriter(&gzipped)
+	_, _ = gw.Write(data)
+	gw.Close()
+
+	var zstded bytes.Buffer
+	zw, _ := zstd.NewWriter(&zstded)
+	_, _ = zw.Write(data)
+	zw.Close()
+
+	var tarred bytes.Buffer
+	tw := tar.NewWriter(&tarred)
+	_ = tw.WriteHeader(&tar.Header{Name: "disk.raw", Mode: 0600, Size: int64(len(data)), Typeflag: tar.TypeReg})
+	_, _ = tw.Write(data)
+	tw.Close()
+
+	var tarredGzipped bytes.Buffer
+	gw = gzip.NewWriter(&tarredGzipped)
+	_, _ = gw.Write(tarred.Bytes())
+	gw.Close()
+
+	cases := []struct {
+		name              string
+		src               []byte
+		compressionFormat string
+		archivingFormat   string
+	}{
+		{"plain", data, "", ""},
+		{"gzip", gzipped.Bytes(), compressionFormatGZIP, ""},
+		{"zstd", zstded.Bytes(), compressionFormatZSTD, ""},
+		{"auto zstd", zstded.Bytes(), compressionFormatAuto, ""},
+		{"tar", tarred.Bytes(), "", archivingFormatTAR},
+		{"auto gzip tar", tarredGzipped.Bytes(), compressionFormatAuto, archivingFormatTAR},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			rc, err := unpackImageData(io.NopCloser(bytes.NewReader(c.src)), c.compressionFormat, c.archivingFormat)
+			assert.NoError(t, err)
+
+			pipe := newChunkPipe(rc)
+			defer pipe.Close()
+
+			actual, err := io.ReadAll(pipe)
+			assert.NoError(t, err)
+			assert.NoError(t, rc.Close())
+			assert.Equal(t, len(data), len(actual))
+			assert.True(t, bytes.Equal(data, actual))
+		})
+	}
+}
+
+func TestUnpackImageDataUnknownFormat(t *testing.T) {
+	_, err := unpackImageData(io.NopCloser(bytes.NewReader([]byte("not compressed"))), compressionFormatAuto, "")
+	assert.Error(t, err)
+}
+
+// endlessImageData produces image data until it is closed and records reads
+// made after that.
+type endlessImageData struct {
+	mu             sync.Mutex
+	closed         bool
+	readAfterClose bool
+}
+
+func (r *endlessImageData) Read(b []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.closed {
+		r.readAfterClose = true
+		return 0, errors.New("read after close")
+	}
+	// Produce data slowly, so the source is being read when the upload is
+	// canceled.
+	time.Sleep(100 * time.Microsecond)
+	n := min(len(b), 64*1024)
+	for i := range b[:n] {
+		b[i] = 'x'
+	}
+	return n, nil
+}
+
+func (r *endlessImageData) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.closed = true
+	return nil
+}
+
+func TestResourceImagesImageUploadAttemptCanceled(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	const imageID = "a1b2c3d4"
+	th.Mux.HandleFunc("/images/"+imageID+"/file", func(w http.ResponseWriter, r *http.Request) {
+		// Cancel the upload partway through.
+		_, _ = io.CopyN(io.Discard, r.Body, imageUploadChunkSize)
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	src := &endlessImageData{}
+	open := func() (io.ReadCloser, error) {
+		return unpackImageData(src, "", "")
+	}
+
+	_, err := resourceImagesImageUploadAttempt(thclient.ServiceClient(), imageID, open, false)
+	assert.Error(t, err)
+
+	// Give a still running reader of the source a chance to read it.
+	time.Sleep(50 * time.Millisecond)
+
+	src.mu.Lock()
+	defer src.mu.Unlock()
+	assert.True(t, src.closed)
+	assert.False(t, src.readAfterClose)
+}
+
+func TestResourceImagesImageUploadAttemptUnauthorized(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	const imageID = "a1b2c3d4"
+	th.Mux.HandleFunc("/images/"+imageID+"/file", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.Copy(io.Discard, r.Body)
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	client := thclient.ServiceClient()
+	reauthenticated := false
+	client.ProviderClient.ReauthFunc = func() error {
+		reauthenticated = true
+		return nil
+	}
+
+	open := func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader([]byte("image data"))), nil
+	}
+
+	// The upload is not repeated after reauthentication with the rest of
+	// the stream, the whole attempt fails instead.
+	_, err := resourceImagesImageUploadAttempt(client, imageID, open, false)
+	assert.Error(t, err)
+	assert.False(t, reauthenticated)
+	assert.NotNil(t, client.ProviderClient.ReauthFunc)
+}
@@ -30,6 +30,7 @@ var (
 	OsExtNetNameNeutron = os.Getenv("OS_EXT_NET_NAME_NEUTRON")
 	OsAvailabilityZone  = os.Getenv("OS_AVAILABILITY_ZONE")
 	OsVolumeType        = os.Getenv("OS_VOLUME_TYPE")
+	OsTargetProjectID   = os.Getenv("OS_TARGET_PROJECT_ID")
 )
 
 var AccTestValues map[string]string = map[string]string{
@@ -48,6 +49,7 @@ var AccTestValues map[string]string = map[string]string{
 	"ExtNetName":            OsExtNetName,
 	"ExtNetNameNeutron":     OsExtNetNameNeutron,
 	"ProjectID":             OsProjectID,
+	"TargetProjectID":       OsTargetProjectID,
 }
 
 var AccTestProviders map[string]func() (*schema.Provider, error)
@@ -0,0 +1,31 @@
+package volumetransfers
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/volumetransfers"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/util/errutil"
+)
+
+func Create(client *gophercloud.ServiceClient, opts volumetransfers.CreateOpts) volumetransfers.CreateResult {
+	r := volumetransfers.Create(client, opts)
+	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
+	return r
+}
+
+func Accept(client *gophercloud.ServiceClient, id string, opts volumetransfers.AcceptOpts) volumetransfers.CreateResult {
+	r := volumetransfers.Accept(client, id, opts)
+	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
+	return r
+}
+
+func Get(client *gophercloud.ServiceClient, id string) volumetransfers.GetResult {
+	r := volumetransfers.Get(client, id)
+	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
+	return r
+}
+
+func Delete(client *gophercloud.ServiceClient, id string) volumetransfers.DeleteResult {
+	r := volumetransfers.Delete(client, id)
+	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
+	return r
+}
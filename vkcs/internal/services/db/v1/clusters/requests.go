@@ -19,18 +19,19 @@ type Cluster struct {
 
 // CreateOpts represents database cluster creation parameters
 type CreateOpts struct {
-	Name                   string                     `json:"name" required:"true"`
-	Datastore              *datastores.DatastoreShort `json:"datastore" required:"true"`
-	FloatingIPEnabled      bool                       `json:"allow_remote_access,omitempty"`
-	AutoExpand             int                        `json:"volume_autoresize_enabled,omitempty"`
-	MaxDiskSize            int                        `json:"volume_autoresize_max_size,omitempty"`
-	WalAutoExpand          int                        `json:"wal_autoresize_enabled,omitempty"`
-	WalMaxDiskSize         int                        `json:"wal_autoresize_max_size,omitempty"`
-	Instances              []InstanceCreateOpts       `json:"instances"`
-	Capabilities           []instances.CapabilityOpts `json:"capabilities,omitempty"`
-	RestorePoint           *instances.RestorePoint    `json:"restorePoint,omitempty"`
-	BackupSchedule         *instances.BackupSchedule  `json:"backup_schedule,omitempty"`
-	CloudMonitoringEnabled bool                       `json:"cloud_monitoring_enabled,omitempty"`
+	Name                   string                       `json:"name" required:"true"`
+	Datastore              *datastores.DatastoreShort   `json:"datastore" required:"true"`
+	FloatingIPEnabled      bool                         `json:"allow_remote_access,omitempty"`
+	AutoExpand             int                          `json:"volume_autoresize_enabled,omitempty"`
+	MaxDiskSize            int                          `json:"volume_autoresize_max_size,omitempty"`
+	WalAutoExpand          int                          `json:"wal_autoresize_enabled,omitempty"`
+	WalMaxDiskSize         int                          `json:"wal_autoresize_max_size,omitempty"`
+	Instances              []InstanceCreateOpts         `json:"instances"`
+	Capabilities           []instances.CapabilityOpts   `json:"capabilities,omitempty"`
+	RestorePoint           *instances.RestorePoint      `json:"restorePoint,omitempty"`
+	BackupSchedule         *instances.BackupSchedule    `json:"backup_schedule,omitempty"`
+	CloudMonitoringEnabled bool                         `json:"cloud_monitoring_enabled,omitempty"`
+	MaintenanceWindow      *instances.MaintenanceWindow `json:"maintenance_window,omitempty"`
 }
 
 // InstanceCreateOpts represents database cluster instance creation parameters
@@ -131,12 +132,14 @@ type GrowClusterOpts struct {
 
 // GrowOpts represents parameters of growing cluster
 type GrowOpts struct {
-	Keypair          string               `json:"key_name"`
-	AvailabilityZone string               `json:"availability_zone" required:"true"`
-	FlavorRef        string               `json:"flavorRef" required:"true"`
-	Volume           *instances.Volume    `json:"volume" required:"true"`
-	Walvolume        *instances.WalVolume `json:"wal_volume,omitempty"`
-	ShardID          string               `json:"shard_id,omitempty"`
+	Keypair          string                  `json:"key_name"`
+	AvailabilityZone string                  `json:"availability_zone" required:"true"`
+	FlavorRef        string                  `json:"flavorRef" required:"true"`
+	Volume           *instances.Volume       `json:"volume" required:"true"`
+	Walvolume        *instances.WalVolume    `json:"wal_volume,omitempty"`
+	ShardID          string                  `json:"shard_id,omitempty"`
+	Nics             []instances.NetworkOpts `json:"nics,omitempty"`
+	SecurityGroups   []string                `json:"security_groups,omitempty"`
 }
 
 // ShrinkClusterOpts is used to send proper request to shrink database cluster
@@ -149,6 +152,25 @@ type ShrinkOpts struct {
 	ID string `json:"id" required:"true"`
 }
 
+// UpdateMaintenanceWindowOpts represents parameters of updating maintenance window of database cluster
+type UpdateMaintenanceWindowOpts struct {
+	Cluster struct {
+		MaintenanceWindow instances.MaintenanceWindow `json:"maintenance_window"`
+	} `json:"cluster"`
+}
+
+// UpgradeOpts is used to send request to upgrade datastore version of database cluster
+type UpgradeOpts struct {
+	Upgrade struct {
+		DatastoreVersion string `json:"datastore_version"`
+	} `json:"upgrade"`
+}
+
+// SwitchoverOpts is used to send request to switch the primary role to another instance of database cluster
+type SwitchoverOpts struct {
+	Switchover struct{} `json:"switchover"`
+}
+
 // Map converts opts to a map (for a request body)
 func (opts Cluster) Map() (map[string]interface{}, error) {
 	return gophercloud.BuildRequestBody(opts, "")
@@ -220,6 +242,24 @@ func (opts *ShrinkClusterOpts) Map() (map[string]interface{}, error) {
 	return body, err
 }
 
+// Map converts opts to a map (for a request body)
+func (opts *SwitchoverOpts) Map() (map[string]interface{}, error) {
+	body, err := gophercloud.BuildRequestBody(*opts, "")
+	return body, err
+}
+
+// Map converts opts to a map (for a request body)
+func (opts *UpdateMaintenanceWindowOpts) Map() (map[string]interface{}, error) {
+	body, err := gophercloud.BuildRequestBody(*opts, "")
+	return body, err
+}
+
+// Map converts opts to a map (for a request body)
+func (opts *UpgradeOpts) Map() (map[string]interface{}, error) {
+	body, err := gophercloud.BuildRequestBody(*opts, "")
+	return body, err
+}
+
 // Create performs request to create database cluster
 func Create(client *gophercloud.ServiceClient, opts OptsBuilder) (r CreateResult) {
 	b, err := opts.Map()
@@ -293,6 +333,21 @@ func UpdateAutoExpand(client *gophercloud.ServiceClient, id string, opts OptsBui
 	return
 }
 
+// Update performs request to update database cluster parameters, e.g. maintenance window
+func Update(client *gophercloud.ServiceClient, id string, opts OptsBuilder) (r ActionResult) {
+	b, err := opts.Map()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Patch(clusterURL(client, id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
+	return
+}
+
 func UpdateBackupSchedule(client *gophercloud.ServiceClient, id string, opts OptsBuilder) (r UpdateBackupScheduleResult) {
 	b, err := opts.Map()
 	if err != nil {
@@ -10,22 +10,23 @@ import (
 
 // ClusterResp represents database cluster response
 type ClusterResp struct {
-	ConfigurationID string                     `json:"configuration_id"`
-	Created         db.DateTimeWithoutTZFormat `json:"created"`
-	DataStore       *datastores.DatastoreShort `json:"datastore"`
-	HealthStatus    string                     `json:"health_status"`
-	ID              string                     `json:"id"`
-	Instances       []ClusterInstanceResp      `json:"instances"`
-	Links           *[]instances.Link          `json:"links"`
-	LoadbalancerID  string                     `json:"loadbalancer_id"`
-	Name            string                     `json:"name"`
-	Task            Task                       `json:"task"`
-	Updated         db.DateTimeWithoutTZFormat `json:"updated"`
-	AutoExpand      int                        `json:"volume_autoresize_enabled"`
-	MaxDiskSize     int                        `json:"volume_autoresize_max_size"`
-	VRRPPortID      string                     `json:"vrrp_port_id"`
-	WalAutoExpand   int                        `json:"wal_autoresize_enabled"`
-	WalMaxDiskSize  int                        `json:"wal_autoresize_max_size"`
+	ConfigurationID   string                       `json:"configuration_id"`
+	Created           db.DateTimeWithoutTZFormat   `json:"created"`
+	DataStore         *datastores.DatastoreShort   `json:"datastore"`
+	HealthStatus      string                       `json:"health_status"`
+	ID                string                       `json:"id"`
+	Instances         []ClusterInstanceResp        `json:"instances"`
+	Links             *[]instances.Link            `json:"links"`
+	LoadbalancerID    string                       `json:"loadbalancer_id"`
+	Name              string                       `json:"name"`
+	Task              Task                         `json:"task"`
+	Updated           db.DateTimeWithoutTZFormat   `json:"updated"`
+	AutoExpand        int                          `json:"volume_autoresize_enabled"`
+	MaxDiskSize       int                          `json:"volume_autoresize_max_size"`
+	VRRPPortID        string                       `json:"vrrp_port_id"`
+	WalAutoExpand     int                          `json:"wal_autoresize_enabled"`
+	WalMaxDiskSize    int                          `json:"wal_autoresize_max_size"`
+	MaintenanceWindow *instances.MaintenanceWindow `json:"maintenance_window"`
 }
 
 // ClusterInstanceResp represents database cluster instance response
@@ -36,6 +36,7 @@ type CreateOpts struct {
 	BackupSchedule         *BackupSchedule            `json:"backup_schedule,omitempty"`
 	CloudMonitoringEnabled bool                       `json:"cloud_monitoring_enabled,omitempty"`
 	SecurityGroups         []string                   `json:"security_groups,omitempty"`
+	MaintenanceWindow      *MaintenanceWindow         `json:"maintenance_window,omitempty"`
 }
 
 // NetworkOpts represents network parameters of database instance
@@ -87,6 +88,35 @@ type UpdateAutoExpandOpts struct {
 	} `json:"instance"`
 }
 
+// UpdateMaintenanceWindowOpts represents parameters of updating maintenance window of database instance
+type UpdateMaintenanceWindowOpts struct {
+	Instance struct {
+		MaintenanceWindow MaintenanceWindow `json:"maintenance_window"`
+	} `json:"instance"`
+}
+
+// UpgradeOpts represents parameters of upgrading datastore version of database instance
+type UpgradeOpts struct {
+	Instance struct {
+		DatastoreVersion string `json:"datastore_version"`
+	} `json:"instance"`
+}
+
+// LogActionOpts represents parameters of an action on a log of database instance.
+// Only one of Enable, Disable, Publish and Discard should be set.
+type LogActionOpts struct {
+	Name    string `json:"name" required:"true"`
+	Enable  int    `json:"enable,omitempty"`
+	Disable int    `json:"disable,omitempty"`
+	Publish int    `json:"publish,omitempty"`
+	Discard int    `json:"discard,omitempty"`
+}
+
+// GetLogContentOpts represents parameters of getting content of a log of database instance
+type GetLogContentOpts struct {
+	Lines int `q:"lines"`
+}
+
 // ResizeVolumeOpts represents database instance volume resize parameters
 type ResizeVolumeOpts struct {
 	Resize struct {
@@ -129,6 +159,11 @@ type ResizeOpts struct {
 	} `json:"resize"`
 }
 
+// RestartOpts represents parameters of request to restart database instance
+type RestartOpts struct {
+	Restart struct{} `json:"restart"`
+}
+
 // RootUserEnableOpts represents parameters of request to enable root user for database instance
 type RootUserEnableOpts struct {
 	Password string `json:"password,omitempty"`
@@ -206,6 +241,12 @@ func (opts *ResizeOpts) Map() (map[string]interface{}, error) {
 	return body, err
 }
 
+// Map converts opts to a map (for a request body)
+func (opts *RestartOpts) Map() (map[string]interface{}, error) {
+	body, err := gophercloud.BuildRequestBody(*opts, "")
+	return body, err
+}
+
 // Map converts opts to a map (for a request body)
 func (opts *RootUserEnableOpts) Map() (map[string]interface{}, error) {
 	body, err := gophercloud.BuildRequestBody(*opts, "")
@@ -218,6 +259,33 @@ func (opts *UpdateCloudMonitoringOpts) Map() (map[string]interface{}, error) {
 	return body, err
 }
 
+// Map converts opts to a map (for a request body)
+func (opts *UpdateMaintenanceWindowOpts) Map() (map[string]interface{}, error) {
+	body, err := gophercloud.BuildRequestBody(*opts, "")
+	return body, err
+}
+
+// Map converts opts to a map (for a request body)
+func (opts *UpgradeOpts) Map() (map[string]interface{}, error) {
+	body, err := gophercloud.BuildRequestBody(*opts, "")
+	return body, err
+}
+
+// Map converts opts to a map (for a request body)
+func (opts *LogActionOpts) Map() (map[string]interface{}, error) {
+	body, err := gophercloud.BuildRequestBody(*opts, "")
+	return body, err
+}
+
+// ToLogContentQuery formats opts into a query string
+func (opts GetLogContentOpts) ToLogContentQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), nil
+}
+
 // Map converts opts to a map (for a request body)
 func (opts *UpdateAutoExpandOpts) Map() (map[string]interface{}, error) {
 	body, err := gophercloud.BuildRequestBody(*opts, "")
@@ -314,6 +382,21 @@ func UpdateAutoExpand(client *gophercloud.ServiceClient, id string, opts OptsBui
 	return
 }
 
+// Update performs request to update database instance parameters, e.g. maintenance window or datastore version
+func Update(client *gophercloud.ServiceClient, id string, opts OptsBuilder) (r ActionResult) {
+	b, err := opts.Map()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Patch(instanceURL(client, id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
+	return
+}
+
 // DetachConfigurationGroup performs request to detach configuration group from database instance
 func DetachConfigurationGroup(client *gophercloud.ServiceClient, id string, opts OptsBuilder) (r ConfigurationResult) {
 	b, err := opts.Map()
@@ -408,6 +491,48 @@ func UpdateBackupSchedule(client *gophercloud.ServiceClient, id string, opts Opt
 	return
 }
 
+// ListLogs performs request to list logs of database instance
+func ListLogs(client *gophercloud.ServiceClient, id string) (r ListLogsResult) {
+	resp, err := client.Get(logsURL(client, id), &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
+	return
+}
+
+// LogAction performs request to enable, disable, publish or discard a log of database instance
+func LogAction(client *gophercloud.ServiceClient, id string, opts OptsBuilder) (r LogActionResult) {
+	b, err := opts.Map()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(logsURL(client, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
+	return
+}
+
+// GetLogContent performs request to get latest lines of a published log of database instance
+func GetLogContent(client *gophercloud.ServiceClient, id string, name string, opts GetLogContentOpts) (r GetLogContentResult) {
+	url := logURL(client, id, name)
+	query, err := opts.ToLogContentQuery()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	url += query
+	resp, err := client.Get(url, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
+	return
+}
+
 // Delete performs request to delete database instance
 func Delete(client *gophercloud.ServiceClient, id string) (r DeleteResult) {
 	resp, err := client.Delete(instanceURL(client, id), &gophercloud.RequestOpts{})
@@ -26,9 +26,11 @@ type InstanceResp struct {
 	Status            string                     `json:"status"`
 	Volume            *Volume                    `json:"volume"`
 	ReplicaOf         *Links                     `json:"replica_of"`
+	ReplicationLag    *int                       `json:"replication_lag"`
 	AutoExpand        int                        `json:"volume_autoresize_enabled"`
 	MaxDiskSize       int                        `json:"volume_autoresize_max_size"`
 	WalVolume         *WalVolume                 `json:"wal_volume"`
+	MaintenanceWindow *MaintenanceWindow         `json:"maintenance_window"`
 }
 
 type InstanceShortResp struct {
@@ -95,6 +97,13 @@ type BackupSchedule struct {
 	KeepCount     int    `json:"keep_count"`
 }
 
+// MaintenanceWindow represents weekly window for maintenance of database instance or cluster
+type MaintenanceWindow struct {
+	Day       string `json:"day"`
+	StartHour int    `json:"start_hour"`
+	Duration  int    `json:"duration"`
+}
+
 type commonInstanceResult struct {
 	gophercloud.Result
 }
@@ -257,3 +266,60 @@ func (r commonBackupScheduleResult) Extract() (*BackupSchedule, error) {
 	}
 	return b.BackupSchedule, nil
 }
+
+// Log represents a log of database instance
+type Log struct {
+	Name      string `json:"name"`
+	Type      string `json:"type"`
+	Status    string `json:"status"`
+	Published int    `json:"published"`
+	Pending   int    `json:"pending"`
+	Container string `json:"container"`
+	Prefix    string `json:"prefix"`
+	Metafile  string `json:"metafile"`
+}
+
+// LogContent represents latest lines of a log of database instance
+type LogContent struct {
+	Name  string   `json:"name"`
+	Lines []string `json:"lines"`
+}
+
+type ListLogsResult struct {
+	gophercloud.Result
+}
+
+type LogActionResult struct {
+	gophercloud.Result
+}
+
+type GetLogContentResult struct {
+	gophercloud.Result
+}
+
+// Extract is used to extract result into slice of logs
+func (r ListLogsResult) Extract() ([]Log, error) {
+	var s struct {
+		Logs []Log `json:"logs"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Logs, err
+}
+
+// Extract is used to extract result into log
+func (r LogActionResult) Extract() (*Log, error) {
+	var s struct {
+		Log *Log `json:"log"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Log, err
+}
+
+// Extract is used to extract result into log content
+func (r GetLogContentResult) Extract() (*LogContent, error) {
+	var s struct {
+		Log *LogContent `json:"log"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Log, err
+}
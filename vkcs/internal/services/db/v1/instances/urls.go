@@ -29,3 +29,11 @@ func capabilitiesURL(c *gophercloud.ServiceClient, id string) string {
 func backupScheduleURL(c *gophercloud.ServiceClient, id string) string {
 	return c.ServiceURL(baseURL(), id, "backup_schedule")
 }
+
+func logsURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL(baseURL(), id, "log")
+}
+
+func logURL(c *gophercloud.ServiceClient, id string, name string) string {
+	return c.ServiceURL(baseURL(), id, "log", name)
+}
@@ -23,3 +23,36 @@ func Delete(c *gophercloud.ServiceClient, id string) rules.DeleteResult {
 	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
 	return r
 }
+
+// CreateBulkOpts represents the attributes used when creating several
+// security group rules with a single request.
+type CreateBulkOpts []rules.CreateOpts
+
+// ToSecGroupRuleCreateBulkMap builds a request body from CreateBulkOpts.
+func (opts CreateBulkOpts) ToSecGroupRuleCreateBulkMap() (map[string]interface{}, error) {
+	sgRules := make([]interface{}, len(opts))
+	for i, opt := range opts {
+		b, err := opt.ToSecGroupRuleCreateMap()
+		if err != nil {
+			return nil, err
+		}
+		sgRules[i] = b["security_group_rule"]
+	}
+
+	return map[string]interface{}{"security_group_rules": sgRules}, nil
+}
+
+func CreateBulk(c *gophercloud.ServiceClient, opts CreateBulkOpts) (r CreateBulkResult) {
+	b, err := opts.ToSecGroupRuleCreateBulkMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	resp, err := c.Post(rulesURL(c), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
+	return
+}
@@ -1,7 +1,24 @@
 package rules
 
-import "github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/rules"
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/rules"
+)
 
 func ExtractSecurityGroupRuleInto(r rules.GetResult, v interface{}) error {
 	return r.ExtractIntoStructPtr(v, "security_group_rule")
 }
+
+// CreateBulkResult represents the result of a bulk create operation.
+type CreateBulkResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a CreateBulkResult as a slice of security group rules.
+func (r CreateBulkResult) Extract() ([]rules.SecGroupRule, error) {
+	var s struct {
+		SecGroupRules []rules.SecGroupRule `json:"security_group_rules"`
+	}
+	err := r.ExtractInto(&s)
+	return s.SecGroupRules, err
+}
@@ -0,0 +1,7 @@
+package rules
+
+import "github.com/gophercloud/gophercloud"
+
+func rulesURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("security-group-rules")
+}
@@ -13,3 +13,9 @@ func Upload(client *gophercloud.ServiceClient, id string, data io.Reader) imaged
 	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
 	return r
 }
+
+func Stage(client *gophercloud.ServiceClient, id string, data io.Reader) imagedata.StageResult {
+	r := imagedata.Stage(client, id, data)
+	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
+	return r
+}
@@ -0,0 +1,27 @@
+package imageimport
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/imageimport"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/util/errutil"
+)
+
+func Get(client *gophercloud.ServiceClient) imageimport.GetResult {
+	r := imageimport.Get(client)
+	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
+	return r
+}
+
+func Create(client *gophercloud.ServiceClient, imageID string, opts imageimport.CreateOptsBuilder) imageimport.CreateResult {
+	r := imageimport.Create(client, imageID, opts)
+	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
+	return r
+}
+
+// ListTasks returns tasks associated with the image, e.g. import tasks.
+func ListTasks(client *gophercloud.ServiceClient, imageID string) (r ListTasksResult) {
+	resp, err := client.Get(tasksURL(client, imageID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
+	return
+}
@@ -0,0 +1,29 @@
+package imageimport
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// Task represents an image task, e.g. an import task.
+type Task struct {
+	ID      string                 `json:"id"`
+	Type    string                 `json:"type"`
+	Status  string                 `json:"status"`
+	Message string                 `json:"message"`
+	Result  map[string]interface{} `json:"result"`
+}
+
+// ListTasksResult is the result of a list image tasks request. Call its
+// Extract method to interpret the result as a slice of tasks.
+type ListTasksResult struct {
+	gophercloud.Result
+}
+
+// Extract extracts tasks from a ListTasksResult.
+func (r ListTasksResult) Extract() ([]Task, error) {
+	var s struct {
+		Tasks []Task `json:"tasks"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Tasks, err
+}
@@ -0,0 +1,7 @@
+package imageimport
+
+import "github.com/gophercloud/gophercloud"
+
+func tasksURL(c *gophercloud.ServiceClient, imageID string) string {
+	return c.ServiceURL("images", imageID, "tasks")
+}
@@ -0,0 +1,31 @@
+package members
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/members"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/util/errutil"
+)
+
+func Create(client *gophercloud.ServiceClient, imageID, memberID string) members.CreateResult {
+	r := members.Create(client, imageID, memberID)
+	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
+	return r
+}
+
+func Get(client *gophercloud.ServiceClient, imageID, memberID string) members.DetailsResult {
+	r := members.Get(client, imageID, memberID)
+	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
+	return r
+}
+
+func Update(client *gophercloud.ServiceClient, imageID, memberID string, opts members.UpdateOptsBuilder) members.UpdateResult {
+	r := members.Update(client, imageID, memberID, opts)
+	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
+	return r
+}
+
+func Delete(client *gophercloud.ServiceClient, imageID, memberID string) members.DeleteResult {
+	r := members.Delete(client, imageID, memberID)
+	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
+	return r
+}
@@ -0,0 +1,15 @@
+package availabilityzones
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// List returns a Pager which allows you to iterate over a collection of
+// load balancer availability zones.
+func List(client *gophercloud.ServiceClient) pagination.Pager {
+	return pagination.NewPager(client, availabilityZonesURL(client),
+		func(r pagination.PageResult) pagination.Page {
+			return Page{pagination.SinglePageBase(r)}
+		})
+}
@@ -0,0 +1,34 @@
+package availabilityzones
+
+import (
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// AvailabilityZone represents a load balancer availability zone.
+type AvailabilityZone struct {
+	Name                      string `json:"name"`
+	Description               string `json:"description"`
+	Enabled                   bool   `json:"enabled"`
+	AvailabilityZoneProfileID string `json:"availability_zone_profile_id"`
+}
+
+// Page represents a page of load balancer availability zones.
+type Page struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty indicates whether an AvailabilityZone collection is empty.
+func (r Page) IsEmpty() (bool, error) {
+	is, err := ExtractAvailabilityZones(r)
+	return len(is) == 0, err
+}
+
+// ExtractAvailabilityZones retrieves a slice of AvailabilityZone structs from
+// a paginated collection.
+func ExtractAvailabilityZones(r pagination.Page) ([]AvailabilityZone, error) {
+	var s struct {
+		AvailabilityZones []AvailabilityZone `json:"availability_zones"`
+	}
+	err := (r.(Page)).ExtractInto(&s)
+	return s.AvailabilityZones, err
+}
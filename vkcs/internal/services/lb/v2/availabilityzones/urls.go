@@ -0,0 +1,7 @@
+package availabilityzones
+
+import "github.com/gophercloud/gophercloud"
+
+func availabilityZonesURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("lbaas", "availabilityzones")
+}
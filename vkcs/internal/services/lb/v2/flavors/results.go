@@ -0,0 +1,35 @@
+package flavors
+
+import (
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Flavor represents a load balancer flavor.
+type Flavor struct {
+	ID              string `json:"id"`
+	Name            string `json:"name"`
+	Description     string `json:"description"`
+	Enabled         bool   `json:"enabled"`
+	FlavorProfileID string `json:"flavor_profile_id"`
+}
+
+// Page represents a page of load balancer flavors.
+type Page struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty indicates whether a Flavor collection is empty.
+func (r Page) IsEmpty() (bool, error) {
+	is, err := ExtractFlavors(r)
+	return len(is) == 0, err
+}
+
+// ExtractFlavors retrieves a slice of Flavor structs from a paginated
+// collection.
+func ExtractFlavors(r pagination.Page) ([]Flavor, error) {
+	var s struct {
+		Flavors []Flavor `json:"flavors"`
+	}
+	err := (r.(Page)).ExtractInto(&s)
+	return s.Flavors, err
+}
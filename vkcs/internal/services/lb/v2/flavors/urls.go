@@ -0,0 +1,7 @@
+package flavors
+
+import "github.com/gophercloud/gophercloud"
+
+func flavorsURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("lbaas", "flavors")
+}
@@ -3,9 +3,65 @@ package listeners
 import (
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/listeners"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/util"
 	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/util/errutil"
 )
 
+// ListenerTLSExt represents the TLS termination and client authentication
+// attributes of a listener.
+type ListenerTLSExt struct {
+	TLSCiphers              string   `json:"tls_ciphers"`
+	ALPNProtocols           []string `json:"alpn_protocols"`
+	ClientAuthentication    string   `json:"client_authentication"`
+	ClientCATLSContainerRef string   `json:"client_ca_tls_container_ref"`
+	ClientCRLContainerRef   string   `json:"client_crl_container_ref"`
+}
+
+// ListenerCreateOpts represents the attributes used when creating a new listener.
+type ListenerCreateOpts struct {
+	listeners.CreateOpts
+	TLSCiphers              string   `json:"tls_ciphers,omitempty"`
+	ALPNProtocols           []string `json:"alpn_protocols,omitempty"`
+	ClientAuthentication    string   `json:"client_authentication,omitempty"`
+	ClientCATLSContainerRef string   `json:"client_ca_tls_container_ref,omitempty"`
+	ClientCRLContainerRef   string   `json:"client_crl_container_ref,omitempty"`
+}
+
+// ToListenerCreateMap casts a ListenerCreateOpts struct to a map.
+// It overrides listeners.ToListenerCreateMap to add the TLS fields.
+func (opts ListenerCreateOpts) ToListenerCreateMap() (map[string]interface{}, error) {
+	return util.BuildRequest(opts, "listener")
+}
+
+// ListenerUpdateOpts represents the attributes used when updating an existing listener.
+type ListenerUpdateOpts struct {
+	listeners.UpdateOpts
+	TLSCiphers              *string   `json:"tls_ciphers,omitempty"`
+	ALPNProtocols           *[]string `json:"alpn_protocols,omitempty"`
+	ClientAuthentication    *string   `json:"client_authentication,omitempty"`
+	ClientCATLSContainerRef *string   `json:"client_ca_tls_container_ref,omitempty"`
+	ClientCRLContainerRef   *string   `json:"client_crl_container_ref,omitempty"`
+}
+
+// ToListenerUpdateMap casts a ListenerUpdateOpts struct to a map.
+// It overrides listeners.ToListenerUpdateMap to add the TLS fields. Empty
+// default pool and container references are sent as null to unset them.
+func (opts ListenerUpdateOpts) ToListenerUpdateMap() (map[string]interface{}, error) {
+	b, err := util.BuildRequest(opts, "listener")
+	if err != nil {
+		return nil, err
+	}
+
+	listener := b["listener"].(map[string]interface{})
+	for _, k := range []string{"default_pool_id", "default_tls_container_ref", "client_ca_tls_container_ref", "client_crl_container_ref"} {
+		if v, ok := listener[k]; ok && v == "" {
+			listener[k] = nil
+		}
+	}
+
+	return b, nil
+}
+
 func Create(c *gophercloud.ServiceClient, opts listeners.CreateOptsBuilder) listeners.CreateResult {
 	r := listeners.Create(c, opts)
 	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
@@ -18,10 +74,24 @@ func Get(c *gophercloud.ServiceClient, id string) listeners.GetResult {
 	return r
 }
 
-func Update(c *gophercloud.ServiceClient, id string, opts listeners.UpdateOpts) listeners.UpdateResult {
-	r := listeners.Update(c, id, opts)
+func ExtractListenerInto(r listeners.GetResult, v interface{}) error {
+	return r.ExtractIntoStructPtr(v, "listener")
+}
+
+// Update modifies the attributes of the specified listener. Unlike
+// listeners.Update it accepts any listeners.UpdateOptsBuilder.
+func Update(c *gophercloud.ServiceClient, id string, opts listeners.UpdateOptsBuilder) (r listeners.UpdateResult) {
+	b, err := opts.ToListenerUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Put(c.ServiceURL("lbaas", "listeners", id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
-	return r
+	return
 }
 
 func Delete(c *gophercloud.ServiceClient, id string) listeners.DeleteResult {
@@ -29,3 +99,9 @@ func Delete(c *gophercloud.ServiceClient, id string) listeners.DeleteResult {
 	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
 	return r
 }
+
+func GetStats(c *gophercloud.ServiceClient, id string) listeners.StatsResult {
+	r := listeners.GetStats(c, id)
+	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
+	return r
+}
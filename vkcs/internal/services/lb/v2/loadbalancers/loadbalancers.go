@@ -35,3 +35,13 @@ func GetStatuses(c *gophercloud.ServiceClient, id string) loadbalancers.GetStatu
 	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
 	return r
 }
+
+func ExtractStatusesInto(r loadbalancers.GetStatusesResult, v interface{}) error {
+	return r.ExtractIntoStructPtr(v, "statuses")
+}
+
+func Failover(c *gophercloud.ServiceClient, id string) loadbalancers.FailoverResult {
+	r := loadbalancers.Failover(c, id)
+	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
+	return r
+}
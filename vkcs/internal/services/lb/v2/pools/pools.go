@@ -3,9 +3,67 @@ package pools
 import (
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/pools"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/util"
 	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/util/errutil"
 )
 
+// PoolTLSExt represents the backend re-encryption attributes of a pool.
+type PoolTLSExt struct {
+	TLSEnabled        bool     `json:"tls_enabled"`
+	TLSContainerRef   string   `json:"tls_container_ref"`
+	CATLSContainerRef string   `json:"ca_tls_container_ref"`
+	CRLContainerRef   string   `json:"crl_container_ref"`
+	TLSVersions       []string `json:"tls_versions"`
+	TLSCiphers        string   `json:"tls_ciphers"`
+}
+
+// PoolCreateOpts represents the attributes used when creating a new pool.
+type PoolCreateOpts struct {
+	pools.CreateOpts
+	TLSEnabled        bool     `json:"tls_enabled,omitempty"`
+	TLSContainerRef   string   `json:"tls_container_ref,omitempty"`
+	CATLSContainerRef string   `json:"ca_tls_container_ref,omitempty"`
+	CRLContainerRef   string   `json:"crl_container_ref,omitempty"`
+	TLSVersions       []string `json:"tls_versions,omitempty"`
+	TLSCiphers        string   `json:"tls_ciphers,omitempty"`
+}
+
+// ToPoolCreateMap casts a PoolCreateOpts struct to a map.
+// It overrides pools.ToPoolCreateMap to add the TLS fields.
+func (opts PoolCreateOpts) ToPoolCreateMap() (map[string]interface{}, error) {
+	return util.BuildRequest(opts, "pool")
+}
+
+// PoolUpdateOpts represents the attributes used when updating an existing pool.
+type PoolUpdateOpts struct {
+	pools.UpdateOpts
+	TLSEnabled        *bool     `json:"tls_enabled,omitempty"`
+	TLSContainerRef   *string   `json:"tls_container_ref,omitempty"`
+	CATLSContainerRef *string   `json:"ca_tls_container_ref,omitempty"`
+	CRLContainerRef   *string   `json:"crl_container_ref,omitempty"`
+	TLSVersions       *[]string `json:"tls_versions,omitempty"`
+	TLSCiphers        *string   `json:"tls_ciphers,omitempty"`
+}
+
+// ToPoolUpdateMap casts a PoolUpdateOpts struct to a map.
+// It overrides pools.ToPoolUpdateMap to add the TLS fields. Empty container
+// references are sent as null to unset them.
+func (opts PoolUpdateOpts) ToPoolUpdateMap() (map[string]interface{}, error) {
+	b, err := util.BuildRequest(opts, "pool")
+	if err != nil {
+		return nil, err
+	}
+
+	pool := b["pool"].(map[string]interface{})
+	for _, k := range []string{"tls_container_ref", "ca_tls_container_ref", "crl_container_ref"} {
+		if v, ok := pool[k]; ok && v == "" {
+			pool[k] = nil
+		}
+	}
+
+	return b, nil
+}
+
 func Create(c *gophercloud.ServiceClient, opts pools.CreateOptsBuilder) pools.CreateResult {
 	r := pools.Create(c, opts)
 	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
@@ -24,6 +82,10 @@ func Update(c *gophercloud.ServiceClient, id string, opts pools.UpdateOptsBuilde
 	return r
 }
 
+func ExtractPoolInto(r pools.GetResult, v interface{}) error {
+	return r.ExtractIntoStructPtr(v, "pool")
+}
+
 func Delete(c *gophercloud.ServiceClient, id string) pools.DeleteResult {
 	r := pools.Delete(c, id)
 	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
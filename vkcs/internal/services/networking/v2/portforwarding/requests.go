@@ -0,0 +1,93 @@
+package portforwarding
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/portforwarding"
+	"github.com/gophercloud/gophercloud/pagination"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/util/errutil"
+)
+
+// CreateOpts represents the attributes used when creating a new port forwarding.
+// Unlike portforwarding.CreateOpts, it supports port ranges and description.
+type CreateOpts struct {
+	InternalPortID    string `json:"internal_port_id"`
+	InternalIPAddress string `json:"internal_ip_address"`
+	InternalPort      int    `json:"internal_port,omitempty"`
+	InternalPortRange string `json:"internal_port_range,omitempty"`
+	ExternalPort      int    `json:"external_port,omitempty"`
+	ExternalPortRange string `json:"external_port_range,omitempty"`
+	Protocol          string `json:"protocol"`
+	Description       string `json:"description,omitempty"`
+}
+
+func (opts CreateOpts) ToPortForwardingCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "port_forwarding")
+}
+
+// UpdateOpts represents the attributes used when updating an existing port forwarding.
+// Ports and port ranges set to zero values are sent as nulls to clear them.
+type UpdateOpts struct {
+	InternalPortID    string  `json:"internal_port_id,omitempty"`
+	InternalIPAddress string  `json:"internal_ip_address,omitempty"`
+	InternalPort      *int    `json:"-"`
+	InternalPortRange *string `json:"-"`
+	ExternalPort      *int    `json:"-"`
+	ExternalPortRange *string `json:"-"`
+	Protocol          string  `json:"protocol,omitempty"`
+	Description       *string `json:"description,omitempty"`
+}
+
+func (opts UpdateOpts) ToPortForwardingUpdateMap() (map[string]interface{}, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "port_forwarding")
+	if err != nil {
+		return nil, err
+	}
+
+	pf := b["port_forwarding"].(map[string]interface{})
+	for name, port := range map[string]*int{"internal_port": opts.InternalPort, "external_port": opts.ExternalPort} {
+		if port != nil {
+			pf[name] = nil
+			if *port != 0 {
+				pf[name] = *port
+			}
+		}
+	}
+	for name, portRange := range map[string]*string{"internal_port_range": opts.InternalPortRange, "external_port_range": opts.ExternalPortRange} {
+		if portRange != nil {
+			pf[name] = nil
+			if *portRange != "" {
+				pf[name] = *portRange
+			}
+		}
+	}
+
+	return b, nil
+}
+
+func Create(c *gophercloud.ServiceClient, floatingIPID string, opts portforwarding.CreateOptsBuilder) portforwarding.CreateResult {
+	r := portforwarding.Create(c, floatingIPID, opts)
+	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
+	return r
+}
+
+func Get(c *gophercloud.ServiceClient, floatingIPID, id string) portforwarding.GetResult {
+	r := portforwarding.Get(c, floatingIPID, id)
+	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
+	return r
+}
+
+func Update(c *gophercloud.ServiceClient, floatingIPID, id string, opts portforwarding.UpdateOptsBuilder) portforwarding.UpdateResult {
+	r := portforwarding.Update(c, floatingIPID, id, opts)
+	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
+	return r
+}
+
+func Delete(c *gophercloud.ServiceClient, floatingIPID, id string) portforwarding.DeleteResult {
+	r := portforwarding.Delete(c, floatingIPID, id)
+	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
+	return r
+}
+
+func List(c *gophercloud.ServiceClient, floatingIPID string, opts portforwarding.ListOptsBuilder) pagination.Pager {
+	return portforwarding.List(c, opts, floatingIPID)
+}
@@ -0,0 +1,26 @@
+package portforwarding
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/portforwarding"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// PortForwarding represents a floating IP port forwarding including
+// port ranges and description.
+type PortForwarding struct {
+	portforwarding.PortForwarding
+	InternalPortRange string `json:"internal_port_range"`
+	ExternalPortRange string `json:"external_port_range"`
+	Description       string `json:"description"`
+}
+
+func ExtractPortForwardingInto(r gophercloud.Result, v interface{}) error {
+	return r.ExtractIntoStructPtr(v, "port_forwarding")
+}
+
+func ExtractPortForwardings(r pagination.Page) ([]PortForwarding, error) {
+	var s []PortForwarding
+	err := r.(portforwarding.PortForwardingPage).ExtractIntoSlicePtr(&s, "port_forwardings")
+	return s, err
+}
@@ -0,0 +1,83 @@
+package ports
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/trunks"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/util/errutil"
+)
+
+func CreateTrunk(c *gophercloud.ServiceClient, opts trunks.CreateOptsBuilder) trunks.CreateResult {
+	r := trunks.Create(c, opts)
+	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
+	return r
+}
+
+func GetTrunk(c *gophercloud.ServiceClient, id string) trunks.GetResult {
+	r := trunks.Get(c, id)
+	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
+	return r
+}
+
+func UpdateTrunk(c *gophercloud.ServiceClient, id string, opts trunks.UpdateOptsBuilder) trunks.UpdateResult {
+	r := trunks.Update(c, id, opts)
+	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
+	return r
+}
+
+func DeleteTrunk(c *gophercloud.ServiceClient, id string) trunks.DeleteResult {
+	r := trunks.Delete(c, id)
+	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
+	return r
+}
+
+func AddSubports(c *gophercloud.ServiceClient, id string, opts trunks.AddSubportsOptsBuilder) trunks.UpdateSubportsResult {
+	r := trunks.AddSubports(c, id, opts)
+	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
+	return r
+}
+
+func RemoveSubports(c *gophercloud.ServiceClient, id string, opts trunks.RemoveSubportsOptsBuilder) trunks.UpdateSubportsResult {
+	r := trunks.RemoveSubports(c, id, opts)
+	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
+	return r
+}
+
+func ExtractTrunkInto(r trunks.GetResult, v interface{}) error {
+	return r.ExtractIntoStructPtr(v, "trunk")
+}
+
+// TrunkSubport represents a sub-port of a trunk. Unlike trunks.Subport, it
+// omits the segmentation ID if it is not set, since the ID must not be sent
+// for "inherit" segmentation type.
+type TrunkSubport struct {
+	SegmentationID   int    `json:"segmentation_id,omitempty"`
+	SegmentationType string `json:"segmentation_type" required:"true"`
+	PortID           string `json:"port_id" required:"true"`
+}
+
+// CreateTrunkOpts represents the attributes used when creating a new trunk.
+type CreateTrunkOpts struct {
+	PortID       string         `json:"port_id" required:"true"`
+	Name         string         `json:"name,omitempty"`
+	Description  string         `json:"description,omitempty"`
+	AdminStateUp *bool          `json:"admin_state_up,omitempty"`
+	Subports     []TrunkSubport `json:"sub_ports"`
+}
+
+// ToTrunkCreateMap builds a request body from CreateTrunkOpts.
+func (opts CreateTrunkOpts) ToTrunkCreateMap() (map[string]interface{}, error) {
+	if opts.Subports == nil {
+		opts.Subports = []TrunkSubport{}
+	}
+	return gophercloud.BuildRequestBody(opts, "trunk")
+}
+
+// AddSubportsOpts represents the sub-ports to add to a trunk.
+type AddSubportsOpts struct {
+	Subports []TrunkSubport `json:"sub_ports" required:"true"`
+}
+
+// ToTrunkAddSubportsMap builds a request body from AddSubportsOpts.
+func (opts AddSubportsOpts) ToTrunkAddSubportsMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
@@ -0,0 +1,31 @@
+package policies
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/qos/policies"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/util/errutil"
+)
+
+func Create(c *gophercloud.ServiceClient, opts policies.CreateOptsBuilder) policies.CreateResult {
+	r := policies.Create(c, opts)
+	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
+	return r
+}
+
+func Get(c *gophercloud.ServiceClient, id string) policies.GetResult {
+	r := policies.Get(c, id)
+	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
+	return r
+}
+
+func Update(c *gophercloud.ServiceClient, id string, opts policies.UpdateOptsBuilder) policies.UpdateResult {
+	r := policies.Update(c, id, opts)
+	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
+	return r
+}
+
+func Delete(c *gophercloud.ServiceClient, id string) policies.DeleteResult {
+	r := policies.Delete(c, id)
+	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
+	return r
+}
@@ -0,0 +1,9 @@
+package policies
+
+import (
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/qos/policies"
+)
+
+func ExtractPolicyInto(r policies.GetResult, v interface{}) error {
+	return r.ExtractIntoStructPtr(v, "policy")
+}
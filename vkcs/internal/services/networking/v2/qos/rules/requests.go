@@ -0,0 +1,55 @@
+package rules
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/qos/rules"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/util/errutil"
+)
+
+func CreateBandwidthLimitRule(c *gophercloud.ServiceClient, policyID string, opts rules.CreateBandwidthLimitRuleOptsBuilder) rules.CreateBandwidthLimitRuleResult {
+	r := rules.CreateBandwidthLimitRule(c, policyID, opts)
+	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
+	return r
+}
+
+func GetBandwidthLimitRule(c *gophercloud.ServiceClient, policyID, ruleID string) rules.GetBandwidthLimitRuleResult {
+	r := rules.GetBandwidthLimitRule(c, policyID, ruleID)
+	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
+	return r
+}
+
+func UpdateBandwidthLimitRule(c *gophercloud.ServiceClient, policyID, ruleID string, opts rules.UpdateBandwidthLimitRuleOptsBuilder) rules.UpdateBandwidthLimitRuleResult {
+	r := rules.UpdateBandwidthLimitRule(c, policyID, ruleID, opts)
+	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
+	return r
+}
+
+func DeleteBandwidthLimitRule(c *gophercloud.ServiceClient, policyID, ruleID string) rules.DeleteBandwidthLimitRuleResult {
+	r := rules.DeleteBandwidthLimitRule(c, policyID, ruleID)
+	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
+	return r
+}
+
+func CreateDSCPMarkingRule(c *gophercloud.ServiceClient, policyID string, opts rules.CreateDSCPMarkingRuleOptsBuilder) rules.CreateDSCPMarkingRuleResult {
+	r := rules.CreateDSCPMarkingRule(c, policyID, opts)
+	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
+	return r
+}
+
+func GetDSCPMarkingRule(c *gophercloud.ServiceClient, policyID, ruleID string) rules.GetDSCPMarkingRuleResult {
+	r := rules.GetDSCPMarkingRule(c, policyID, ruleID)
+	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
+	return r
+}
+
+func UpdateDSCPMarkingRule(c *gophercloud.ServiceClient, policyID, ruleID string, opts rules.UpdateDSCPMarkingRuleOptsBuilder) rules.UpdateDSCPMarkingRuleResult {
+	r := rules.UpdateDSCPMarkingRule(c, policyID, ruleID, opts)
+	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
+	return r
+}
+
+func DeleteDSCPMarkingRule(c *gophercloud.ServiceClient, policyID, ruleID string) rules.DeleteDSCPMarkingRuleResult {
+	r := rules.DeleteDSCPMarkingRule(c, policyID, ruleID)
+	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
+	return r
+}
@@ -0,0 +1,31 @@
+package rbacpolicies
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/rbacpolicies"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/util/errutil"
+)
+
+func Create(c *gophercloud.ServiceClient, opts rbacpolicies.CreateOptsBuilder) rbacpolicies.CreateResult {
+	r := rbacpolicies.Create(c, opts)
+	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
+	return r
+}
+
+func Get(c *gophercloud.ServiceClient, id string) rbacpolicies.GetResult {
+	r := rbacpolicies.Get(c, id)
+	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
+	return r
+}
+
+func Update(c *gophercloud.ServiceClient, id string, opts rbacpolicies.UpdateOptsBuilder) rbacpolicies.UpdateResult {
+	r := rbacpolicies.Update(c, id, opts)
+	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
+	return r
+}
+
+func Delete(c *gophercloud.ServiceClient, id string) rbacpolicies.DeleteResult {
+	r := rbacpolicies.Delete(c, id)
+	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
+	return r
+}
@@ -0,0 +1,9 @@
+package rbacpolicies
+
+import (
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/rbacpolicies"
+)
+
+func ExtractRBACPolicyInto(r rbacpolicies.GetResult, v interface{}) error {
+	return r.ExtractIntoStructPtr(v, "rbac_policy")
+}
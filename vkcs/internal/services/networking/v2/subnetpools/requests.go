@@ -0,0 +1,31 @@
+package subnetpools
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/subnetpools"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/util/errutil"
+)
+
+func Create(c *gophercloud.ServiceClient, opts subnetpools.CreateOptsBuilder) subnetpools.CreateResult {
+	r := subnetpools.Create(c, opts)
+	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
+	return r
+}
+
+func Get(c *gophercloud.ServiceClient, id string) subnetpools.GetResult {
+	r := subnetpools.Get(c, id)
+	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
+	return r
+}
+
+func Update(c *gophercloud.ServiceClient, id string, opts subnetpools.UpdateOptsBuilder) subnetpools.UpdateResult {
+	r := subnetpools.Update(c, id, opts)
+	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
+	return r
+}
+
+func Delete(c *gophercloud.ServiceClient, id string) subnetpools.DeleteResult {
+	r := subnetpools.Delete(c, id)
+	r.Err = errutil.ErrorWithRequestID(r.Err, r.Header.Get(errutil.RequestIDHeader))
+	return r
+}
@@ -0,0 +1,14 @@
+package subnetpools
+
+import (
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/subnetpools"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+func ExtractSubnetPoolInto(r subnetpools.GetResult, v interface{}) error {
+	return r.ExtractIntoStructPtr(v, "subnetpool")
+}
+
+func ExtractSubnetPoolsInto(r pagination.Page, v interface{}) error {
+	return r.(subnetpools.SubnetPoolPage).ExtractIntoSlicePtr(v, "subnetpools")
+}
@@ -0,0 +1,104 @@
+package lb
+
+import (
+	"context"
+	"log"
+	"sort"
+
+	"github.com/gophercloud/utils/terraform/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/clients"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/lb/v2/availabilityzones"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/util"
+)
+
+func DataSourceAvailabilityZones() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAvailabilityZonesRead,
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The region in which to obtain the Loadbalancer client. If omitted, the `region` argument of the provider is used.",
+			},
+
+			"availability_zones": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the availability zone.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Human-readable description of the availability zone.",
+						},
+						"enabled": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the availability zone can be used to create new Loadbalancers.",
+						},
+					},
+				},
+				Description: "The list of Loadbalancer availability zones, ordered by name.",
+			},
+
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Hash of the returned availability zone list.",
+			},
+		},
+		Description: "Use this data source to get a list of availability zones, in which loadbalancers can be created.",
+	}
+}
+
+func dataSourceAvailabilityZonesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(clients.Config)
+	region := util.GetRegion(d, config)
+	lbClient, err := config.LoadBalancerV2Client(region)
+	if err != nil {
+		return diag.Errorf("Error creating VKCS loadbalancer client: %s", err)
+	}
+
+	allPages, err := availabilityzones.List(lbClient).AllPages()
+	if err != nil {
+		return diag.Errorf("Error retrieving vkcs_lb_availability_zones: %s", err)
+	}
+
+	allZones, err := availabilityzones.ExtractAvailabilityZones(allPages)
+	if err != nil {
+		return diag.Errorf("Error extracting vkcs_lb_availability_zones from response: %s", err)
+	}
+
+	log.Printf("[DEBUG] Retrieved vkcs_lb_availability_zones: %#v", allZones)
+
+	sort.SliceStable(allZones, func(i, j int) bool {
+		return allZones[i].Name < allZones[j].Name
+	})
+
+	names := make([]string, len(allZones))
+	flattened := make([]map[string]interface{}, len(allZones))
+	for i, zone := range allZones {
+		names[i] = zone.Name
+		flattened[i] = map[string]interface{}{
+			"name":        zone.Name,
+			"description": zone.Description,
+			"enabled":     zone.Enabled,
+		}
+	}
+
+	d.SetId(hashcode.Strings(names))
+	d.Set("region", region)
+	if err := d.Set("availability_zones", flattened); err != nil {
+		return diag.Errorf("Unable to set vkcs_lb_availability_zones availability_zones: %s", err)
+	}
+
+	return nil
+}
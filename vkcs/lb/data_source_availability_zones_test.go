@@ -0,0 +1,28 @@
+package lb_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/acctest"
+)
+
+func TestAccLBAvailabilityZonesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.AccTestPreCheck(t) },
+		ProviderFactories: acctest.AccTestProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBAvailabilityZonesDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.vkcs_lb_availability_zones.zones", "id"),
+					resource.TestCheckResourceAttrSet("data.vkcs_lb_availability_zones.zones", "availability_zones.0.name"),
+				),
+			},
+		},
+	})
+}
+
+const testAccLBAvailabilityZonesDataSourceBasic = `
+data "vkcs_lb_availability_zones" "zones" {}
+`
@@ -0,0 +1,110 @@
+package lb
+
+import (
+	"context"
+	"log"
+	"sort"
+
+	"github.com/gophercloud/utils/terraform/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/clients"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/lb/v2/flavors"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/util"
+)
+
+func DataSourceFlavors() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceFlavorsRead,
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The region in which to obtain the Loadbalancer client. If omitted, the `region` argument of the provider is used.",
+			},
+
+			"flavors": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The UUID of the flavor.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the flavor.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Human-readable description of the flavor.",
+						},
+						"enabled": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the flavor can be used to create new Loadbalancers.",
+						},
+					},
+				},
+				Description: "The list of Loadbalancer flavors, ordered by name.",
+			},
+
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Hash of the returned flavor list.",
+			},
+		},
+		Description: "Use this data source to get a list of available loadbalancer flavors.",
+	}
+}
+
+func dataSourceFlavorsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(clients.Config)
+	region := util.GetRegion(d, config)
+	lbClient, err := config.LoadBalancerV2Client(region)
+	if err != nil {
+		return diag.Errorf("Error creating VKCS loadbalancer client: %s", err)
+	}
+
+	allPages, err := flavors.List(lbClient).AllPages()
+	if err != nil {
+		return diag.Errorf("Error retrieving vkcs_lb_flavors: %s", err)
+	}
+
+	allFlavors, err := flavors.ExtractFlavors(allPages)
+	if err != nil {
+		return diag.Errorf("Error extracting vkcs_lb_flavors from response: %s", err)
+	}
+
+	log.Printf("[DEBUG] Retrieved vkcs_lb_flavors: %#v", allFlavors)
+
+	sort.SliceStable(allFlavors, func(i, j int) bool {
+		return allFlavors[i].Name < allFlavors[j].Name
+	})
+
+	ids := make([]string, len(allFlavors))
+	flattened := make([]map[string]interface{}, len(allFlavors))
+	for i, flavor := range allFlavors {
+		ids[i] = flavor.ID
+		flattened[i] = map[string]interface{}{
+			"id":          flavor.ID,
+			"name":        flavor.Name,
+			"description": flavor.Description,
+			"enabled":     flavor.Enabled,
+		}
+	}
+
+	d.SetId(hashcode.Strings(ids))
+	d.Set("region", region)
+	if err := d.Set("flavors", flattened); err != nil {
+		return diag.Errorf("Unable to set vkcs_lb_flavors flavors: %s", err)
+	}
+
+	return nil
+}
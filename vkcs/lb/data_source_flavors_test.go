@@ -0,0 +1,29 @@
+package lb_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/acctest"
+)
+
+func TestAccLBFlavorsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.AccTestPreCheck(t) },
+		ProviderFactories: acctest.AccTestProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBFlavorsDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.vkcs_lb_flavors.flavors", "id"),
+					resource.TestCheckResourceAttrSet("data.vkcs_lb_flavors.flavors", "flavors.0.id"),
+					resource.TestCheckResourceAttrSet("data.vkcs_lb_flavors.flavors", "flavors.0.name"),
+				),
+			},
+		},
+	})
+}
+
+const testAccLBFlavorsDataSourceBasic = `
+data "vkcs_lb_flavors" "flavors" {}
+`
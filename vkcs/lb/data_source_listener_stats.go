@@ -0,0 +1,89 @@
+package lb
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/clients"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/lb/v2/listeners"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/util"
+)
+
+func DataSourceListenerStats() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceListenerStatsRead,
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The region in which to obtain the Loadbalancer client. If omitted, the `region` argument of the provider is used.",
+			},
+
+			"listener_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The UUID of the Listener.",
+			},
+
+			"active_connections": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of currently active connections.",
+			},
+
+			"total_connections": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The total number of handled connections.",
+			},
+
+			"bytes_in": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The total number of bytes received.",
+			},
+
+			"bytes_out": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The total number of bytes sent.",
+			},
+
+			"request_errors": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The total number of requests that could not be fulfilled.",
+			},
+		},
+		Description: "Use this data source to get the traffic statistics of a loadbalancer listener.",
+	}
+}
+
+func dataSourceListenerStatsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(clients.Config)
+	lbClient, err := config.LoadBalancerV2Client(util.GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating VKCS loadbalancer client: %s", err)
+	}
+
+	listenerID := d.Get("listener_id").(string)
+	stats, err := listeners.GetStats(lbClient, listenerID).Extract()
+	if err != nil {
+		return diag.Errorf("Unable to retrieve vkcs_lb_listener %s stats: %s", listenerID, err)
+	}
+
+	log.Printf("[DEBUG] Retrieved vkcs_lb_listener %s stats: %#v", listenerID, stats)
+
+	d.SetId(listenerID)
+	d.Set("active_connections", stats.ActiveConnections)
+	d.Set("total_connections", stats.TotalConnections)
+	d.Set("bytes_in", stats.BytesIn)
+	d.Set("bytes_out", stats.BytesOut)
+	d.Set("request_errors", stats.RequestErrors)
+	d.Set("region", util.GetRegion(d, config))
+
+	return nil
+}
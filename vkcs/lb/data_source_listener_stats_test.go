@@ -0,0 +1,45 @@
+package lb_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/acctest"
+)
+
+func TestAccLBListenerStatsDataSource_basic(t *testing.T) {
+	baseConfig := acctest.AccTestRenderConfig(testAccLBListenerBase)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.AccTestPreCheck(t) },
+		ProviderFactories: acctest.AccTestProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.AccTestRenderConfig(testAccLBListenerStatsDataSourceBasic, map[string]string{"TestAccLBListenerBase": baseConfig}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.vkcs_lb_listener_stats.stats_1", "id", "vkcs_lb_listener.listener_1", "id"),
+					resource.TestCheckResourceAttr("data.vkcs_lb_listener_stats.stats_1", "active_connections", "0"),
+					resource.TestCheckResourceAttrSet("data.vkcs_lb_listener_stats.stats_1", "total_connections"),
+					resource.TestCheckResourceAttrSet("data.vkcs_lb_listener_stats.stats_1", "bytes_in"),
+					resource.TestCheckResourceAttrSet("data.vkcs_lb_listener_stats.stats_1", "bytes_out"),
+					resource.TestCheckResourceAttrSet("data.vkcs_lb_listener_stats.stats_1", "request_errors"),
+				),
+			},
+		},
+	})
+}
+
+const testAccLBListenerStatsDataSourceBasic = `
+{{ .TestAccLBListenerBase }}
+
+resource "vkcs_lb_listener" "listener_1" {
+  name            = "listener_1"
+  protocol        = "HTTP"
+  protocol_port   = 8080
+  loadbalancer_id = vkcs_lb_loadbalancer.loadbalancer_1.id
+}
+
+data "vkcs_lb_listener_stats" "stats_1" {
+  listener_id = vkcs_lb_listener.listener_1.id
+}
+`
@@ -76,6 +76,12 @@ func DataSourceLoadBalancer() *schema.Resource {
 				Description: "The availability zone of the Loadbalancer.",
 			},
 
+			"flavor_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the Loadbalancer flavor.",
+			},
+
 			"security_group_ids": {
 				Type:        schema.TypeSet,
 				Computed:    true,
@@ -120,6 +126,7 @@ func dataSourceLoadBalancerRead(ctx context.Context, d *schema.ResourceData, met
 	d.Set("vip_address", lb.VipAddress)
 	d.Set("admin_state_up", lb.AdminStateUp)
 	d.Set("availability_zone", lb.AvailabilityZone)
+	d.Set("flavor_id", lb.FlavorID)
 	d.Set("region", util.GetRegion(d, config))
 	d.Set("tags", lb.Tags)
 
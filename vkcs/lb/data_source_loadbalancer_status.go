@@ -0,0 +1,179 @@
+package lb
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/clients"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/lb/v2/loadbalancers"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/util"
+)
+
+func DataSourceLoadBalancerStatus() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLoadBalancerStatusRead,
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The region in which to obtain the Loadbalancer client. If omitted, the `region` argument of the provider is used.",
+			},
+
+			"loadbalancer_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The UUID of the Loadbalancer.",
+			},
+
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the Loadbalancer.",
+			},
+
+			"operating_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The operating status of the Loadbalancer, e.g. `ONLINE`, `DEGRADED`, `ERROR`.",
+			},
+
+			"provisioning_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The provisioning status of the Loadbalancer, e.g. `ACTIVE`, `PENDING_UPDATE`, `ERROR`.",
+			},
+
+			"listeners": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The UUID of the Listener.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the Listener.",
+						},
+						"operating_status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The operating status of the Listener.",
+						},
+						"provisioning_status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The provisioning status of the Listener.",
+						},
+						"pools": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The UUID of the Pool.",
+									},
+									"name": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The name of the Pool.",
+									},
+									"operating_status": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The operating status of the Pool.",
+									},
+									"provisioning_status": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The provisioning status of the Pool.",
+									},
+									"members": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"id": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: "The UUID of the Member.",
+												},
+												"name": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: "The name of the Member.",
+												},
+												"address": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: "The IP address of the Member.",
+												},
+												"protocol_port": {
+													Type:        schema.TypeInt,
+													Computed:    true,
+													Description: "The port on which the Member listens for client traffic.",
+												},
+												"operating_status": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: "The operating status of the Member, e.g. `ONLINE`, `OFFLINE`, `ERROR`, `NO_MONITOR`, `DRAINING`.",
+												},
+												"provisioning_status": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: "The provisioning status of the Member.",
+												},
+											},
+										},
+										Description: "The members of the Pool.",
+									},
+								},
+							},
+							Description: "The pools of the Listener.",
+						},
+					},
+				},
+				Description: "The listeners of the Loadbalancer.",
+			},
+		},
+		Description: "Use this data source to get the operating status tree of a loadbalancer: listeners, their pools and pool members.",
+	}
+}
+
+func dataSourceLoadBalancerStatusRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(clients.Config)
+	lbClient, err := config.LoadBalancerV2Client(util.GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating VKCS loadbalancer client: %s", err)
+	}
+
+	lbID := d.Get("loadbalancer_id").(string)
+	var statuses lbStatusTree
+	err = loadbalancers.ExtractStatusesInto(loadbalancers.GetStatuses(lbClient, lbID), &statuses)
+	if err != nil {
+		return diag.Errorf("Unable to retrieve vkcs_lb_loadbalancer %s status tree: %s", lbID, err)
+	}
+
+	log.Printf("[DEBUG] Retrieved vkcs_lb_loadbalancer %s status tree: %#v", lbID, statuses)
+
+	lb := statuses.Loadbalancer
+	d.SetId(lbID)
+	d.Set("name", lb.Name)
+	d.Set("operating_status", lb.OperatingStatus)
+	d.Set("provisioning_status", lb.ProvisioningStatus)
+	d.Set("region", util.GetRegion(d, config))
+
+	if err := d.Set("listeners", flattenLBListenerStatuses(lb.Listeners)); err != nil {
+		return diag.Errorf("Unable to set vkcs_lb_loadbalancer_status listeners: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package lb_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/acctest"
+)
+
+func TestAccLBLoadBalancerStatusDataSource_basic(t *testing.T) {
+	baseConfig := acctest.AccTestRenderConfig(testAccLBListenerBase)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.AccTestPreCheck(t) },
+		ProviderFactories: acctest.AccTestProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.AccTestRenderConfig(testAccLBLoadBalancerStatusDataSourceBasic, map[string]string{"TestAccLBListenerBase": baseConfig}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.vkcs_lb_loadbalancer_status.status_1", "id", "vkcs_lb_loadbalancer.loadbalancer_1", "id"),
+					resource.TestCheckResourceAttr("data.vkcs_lb_loadbalancer_status.status_1", "provisioning_status", "ACTIVE"),
+					resource.TestCheckResourceAttr("data.vkcs_lb_loadbalancer_status.status_1", "listeners.#", "1"),
+					resource.TestCheckResourceAttrPair("data.vkcs_lb_loadbalancer_status.status_1", "listeners.0.id", "vkcs_lb_listener.listener_1", "id"),
+					resource.TestCheckResourceAttrPair("data.vkcs_lb_loadbalancer_status.status_1", "listeners.0.pools.0.id", "vkcs_lb_pool.pool_1", "id"),
+					resource.TestCheckResourceAttrPair("data.vkcs_lb_loadbalancer_status.status_1", "listeners.0.pools.0.members.0.id", "vkcs_lb_member.member_1", "id"),
+					resource.TestCheckResourceAttr("data.vkcs_lb_loadbalancer_status.status_1", "listeners.0.pools.0.members.0.address", "192.168.199.110"),
+				),
+			},
+		},
+	})
+}
+
+const testAccLBLoadBalancerStatusDataSourceBasic = `
+{{ .TestAccLBListenerBase }}
+
+resource "vkcs_lb_listener" "listener_1" {
+  name            = "listener_1"
+  protocol        = "HTTP"
+  protocol_port   = 8080
+  loadbalancer_id = vkcs_lb_loadbalancer.loadbalancer_1.id
+}
+
+resource "vkcs_lb_pool" "pool_1" {
+  name        = "pool_1"
+  protocol    = "HTTP"
+  lb_method   = "ROUND_ROBIN"
+  listener_id = vkcs_lb_listener.listener_1.id
+}
+
+resource "vkcs_lb_member" "member_1" {
+  address       = "192.168.199.110"
+  protocol_port = 8080
+  pool_id       = vkcs_lb_pool.pool_1.id
+  subnet_id     = vkcs_networking_subnet.subnet_1.id
+}
+
+data "vkcs_lb_loadbalancer_status" "status_1" {
+  loadbalancer_id = vkcs_lb_loadbalancer.loadbalancer_1.id
+
+  depends_on = [vkcs_lb_member.member_1]
+}
+`
@@ -7,6 +7,7 @@ import (
 	"net/url"
 	"time"
 
+	"github.com/gophercloud/gophercloud"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,6 +15,7 @@ import (
 	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/clients"
 	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/lb/v2/listeners"
 	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/util"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/util/errutil"
 
 	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/l7policies"
 	il7policies "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/lb/v2/l7policies"
@@ -108,6 +110,53 @@ func ResourceL7Policy() *schema.Resource {
 				Optional:    true,
 				Description: "The administrative state of the L7 Policy. A valid value is true (UP) or false (DOWN).",
 			},
+
+			"rule": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"COOKIE", "FILE_TYPE", "HEADER", "HOST_NAME", "PATH",
+							}, false),
+							Description: "The L7 Rule type - can either be COOKIE, FILE\\_TYPE, HEADER, HOST\\_NAME or PATH.",
+						},
+
+						"compare_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"CONTAINS", "STARTS_WITH", "ENDS_WITH", "EQUAL_TO", "REGEX",
+							}, false),
+							Description: "The comparison type for the L7 rule - can either be CONTAINS, STARTS\\_WITH, ENDS\\_WITH, EQUAL\\_TO or REGEX.",
+						},
+
+						"key": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The key to use for the comparison. For example, the name of the cookie to evaluate. Valid when `type` is set to COOKIE or HEADER.",
+						},
+
+						"value": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+							Description:  "The value to use for the comparison. For example, the file type to compare.",
+						},
+
+						"invert": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "When true the logic of the rule is inverted. For example, with invert true, equal to would become not equal to. Default is false.",
+						},
+					},
+				},
+				Description: "A set of L7 Rules of the L7 Policy. When specified, the set is authoritative: rules of the policy, which are not in the set, are deleted. Rules of a new policy are created in a single request. Changed rules are added and removed one request at a time, the policy is waited for once after all of them. Removing all `rule` blocks deletes all rules of the policy. Rules are read only while the policy has `rule` blocks, so do not use them together with `vkcs_lb_l7rule` resources for the same policy.",
+			},
 		},
 		Description: "Manages a Load Balancer L7 Policy resource within VKCS.",
 	}
@@ -147,6 +196,15 @@ func resourceL7PolicyCreate(ctx context.Context, d *schema.ResourceData, meta in
 		createOpts.Position = int32(v.(int))
 	}
 
+	if v, ok := d.GetOk("rule"); ok {
+		rules := v.(*schema.Set)
+		err = checkL7PolicyRules(rules)
+		if err != nil {
+			return diag.Errorf("Unable to create L7 Policy: %s", err)
+		}
+		createOpts.Rules = expandLBL7PolicyRules(rules)
+	}
+
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
 
 	timeout := d.Timeout(schema.TimeoutCreate)
@@ -215,6 +273,11 @@ func resourceL7PolicyRead(ctx context.Context, d *schema.ResourceData, meta inte
 
 	log.Printf("[DEBUG] Retrieved L7 Policy %s: %#v", d.Id(), l7Policy)
 
+	l7Rules, err := getLBL7PolicyRules(lbClient, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	d.Set("action", l7Policy.Action)
 	d.Set("description", l7Policy.Description)
 	d.Set("name", l7Policy.Name)
@@ -223,6 +286,11 @@ func resourceL7PolicyRead(ctx context.Context, d *schema.ResourceData, meta inte
 	d.Set("redirect_pool_id", l7Policy.RedirectPoolID)
 	d.Set("region", util.GetRegion(d, config))
 	d.Set("admin_state_up", l7Policy.AdminStateUp)
+	// Rules are managed inline only while the policy has rule blocks,
+	// otherwise they may be managed by vkcs_lb_l7rule resources.
+	if d.Get("rule").(*schema.Set).Len() > 0 {
+		d.Set("rule", flattenLBL7PolicyRules(l7Rules))
+	}
 
 	return nil
 }
@@ -276,6 +344,13 @@ func resourceL7PolicyUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		return diag.FromErr(err)
 	}
 
+	if d.HasChange("rule") {
+		err = checkL7PolicyRules(d.Get("rule").(*schema.Set))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	// Make sure the pool is active before continuing.
 	timeout := d.Timeout(schema.TimeoutUpdate)
 	if redirectPoolID != "" {
@@ -314,17 +389,26 @@ func resourceL7PolicyUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		return diag.FromErr(err)
 	}
 
-	log.Printf("[DEBUG] Updating L7 Policy %s with options: %#v", d.Id(), updateOpts)
-	err = retry.RetryContext(ctx, timeout, func() *retry.RetryError {
-		_, err = il7policies.Update(lbClient, d.Id(), updateOpts).Extract()
+	if d.HasChangeExcept("rule") {
+		log.Printf("[DEBUG] Updating L7 Policy %s with options: %#v", d.Id(), updateOpts)
+		err = retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+			_, err = il7policies.Update(lbClient, d.Id(), updateOpts).Extract()
+			if err != nil {
+				return util.CheckForRetryableError(err)
+			}
+			return nil
+		})
+
 		if err != nil {
-			return util.CheckForRetryableError(err)
+			return diag.Errorf("Unable to update L7 Policy %s: %s", d.Id(), err)
 		}
-		return nil
-	})
+	}
 
-	if err != nil {
-		return diag.Errorf("Unable to update L7 Policy %s: %s", d.Id(), err)
+	if d.HasChange("rule") {
+		err = resourceL7PolicyUpdateRules(ctx, d, lbClient, timeout)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	// Wait for L7 Policy to become active before continuing
@@ -412,6 +496,74 @@ func resourceL7PolicyImport(d *schema.ResourceData, meta interface{}) ([]*schema
 	return []*schema.ResourceData{d}, nil
 }
 
+// resourceL7PolicyUpdateRules deletes rules removed from the rule set and
+// creates added ones. The API has no bulk update of rules, so rules are sent
+// one by one: requests made while the loadbalancer is still updating are
+// retried, and the caller waits for the policy to become active once all
+// rules are submitted.
+func resourceL7PolicyUpdateRules(ctx context.Context, d *schema.ResourceData, lbClient *gophercloud.ServiceClient, timeout time.Duration) error {
+	o, n := d.GetChange("rule")
+	oldRules, newRules := o.(*schema.Set), n.(*schema.Set)
+
+	existing, err := getLBL7PolicyRules(lbClient, d.Id())
+	if err != nil {
+		return err
+	}
+
+	toDelete := lbL7PolicyRulesToDelete(existing, oldRules.Difference(newRules))
+	// Existing rules, which are not tracked in the state, e.g. after import,
+	// are reused instead of being created again.
+	untracked := lbL7PolicyRulesUntracked(existing, oldRules)
+	toCreate := lbL7PolicyRulesToCreate(untracked, newRules.Difference(oldRules))
+
+	for _, ruleID := range toDelete {
+		log.Printf("[DEBUG] Deleting L7 Rule %s of L7 Policy %s", ruleID, d.Id())
+		err = retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+			err := il7policies.DeleteRule(lbClient, d.Id(), ruleID).ExtractErr()
+			if err != nil {
+				if errutil.IsNotFound(err) {
+					return nil
+				}
+				return util.CheckForRetryableError(err)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("error deleting L7 Rule %s of L7 Policy %s: %s", ruleID, d.Id(), err)
+		}
+	}
+
+	for _, createOpts := range toCreate {
+		log.Printf("[DEBUG] Creating L7 Rule of L7 Policy %s with options: %#v", d.Id(), createOpts)
+		err = retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+			_, err := il7policies.CreateRule(lbClient, d.Id(), createOpts).Extract()
+			if err != nil {
+				return util.CheckForRetryableError(err)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("error creating L7 Rule of L7 Policy %s: %s", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+func getLBL7PolicyRules(lbClient *gophercloud.ServiceClient, id string) ([]l7policies.Rule, error) {
+	allPages, err := l7policies.ListRules(lbClient, id, l7policies.ListRulesOpts{}).AllPages()
+	if err != nil {
+		return nil, fmt.Errorf("unable to list L7 Rules of L7 Policy %s: %s", id, err)
+	}
+
+	rules, err := l7policies.ExtractRules(allPages)
+	if err != nil {
+		return nil, fmt.Errorf("unable to extract L7 Rules of L7 Policy %s: %s", id, err)
+	}
+
+	return rules, nil
+}
+
 func checkL7PolicyAction(action, redirectURL, redirectPoolID string) error {
 	if action == "REJECT" {
 		if redirectURL != "" || redirectPoolID != "" {
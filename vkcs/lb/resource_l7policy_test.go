@@ -98,6 +98,47 @@ func TestAccLBL7Policy_basic(t *testing.T) {
 	})
 }
 
+func TestAccLBL7Policy_rules(t *testing.T) {
+	var l7Policy l7policies.L7Policy
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: acctest.AccTestProviders,
+		CheckDestroy:      testAccCheckLBL7PolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.AccTestRenderConfig(testAccCheckLbL7PolicyConfigRules, map[string]string{"TestAccCheckLbL7PolicyConfig": testAccCheckLbL7PolicyConfig}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBL7PolicyExists("vkcs_lb_l7policy.l7policy_1", &l7Policy),
+					resource.TestCheckResourceAttr(
+						"vkcs_lb_l7policy.l7policy_1", "rule.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"vkcs_lb_l7policy.l7policy_1", "rule.*", map[string]string{
+							"type":         "PATH",
+							"compare_type": "STARTS_WITH",
+							"value":        "/api",
+						}),
+				),
+			},
+			{
+				Config: acctest.AccTestRenderConfig(testAccCheckLbL7PolicyConfigRulesUpdate, map[string]string{"TestAccCheckLbL7PolicyConfig": testAccCheckLbL7PolicyConfig}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBL7PolicyExists("vkcs_lb_l7policy.l7policy_1", &l7Policy),
+					resource.TestCheckResourceAttr(
+						"vkcs_lb_l7policy.l7policy_1", "rule.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"vkcs_lb_l7policy.l7policy_1", "rule.*", map[string]string{
+							"type":         "HEADER",
+							"compare_type": "EQUAL_TO",
+							"key":          "X-Version",
+							"value":        "2",
+							"invert":       "true",
+						}),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckLBL7PolicyDestroy(s *terraform.State) error {
 	config := acctest.AccTestProvider.Meta().(clients.Config)
 	lbClient, err := config.LoadBalancerV2Client(acctest.OsRegionName)
@@ -243,3 +284,51 @@ resource "vkcs_lb_l7policy" "l7policy_1" {
   listener_id      = vkcs_lb_listener.listener_1.id
 }
 `
+
+const testAccCheckLbL7PolicyConfigRules = `
+{{.TestAccCheckLbL7PolicyConfig}}
+
+resource "vkcs_lb_l7policy" "l7policy_1" {
+  name         = "test"
+  action       = "REJECT"
+  position     = 1
+  listener_id  = vkcs_lb_listener.listener_1.id
+
+  rule {
+    type         = "HOST_NAME"
+    compare_type = "EQUAL_TO"
+    value        = "api.example.com"
+  }
+
+  rule {
+    type         = "PATH"
+    compare_type = "STARTS_WITH"
+    value        = "/api"
+  }
+}
+`
+
+const testAccCheckLbL7PolicyConfigRulesUpdate = `
+{{.TestAccCheckLbL7PolicyConfig}}
+
+resource "vkcs_lb_l7policy" "l7policy_1" {
+  name         = "test"
+  action       = "REJECT"
+  position     = 1
+  listener_id  = vkcs_lb_listener.listener_1.id
+
+  rule {
+    type         = "HOST_NAME"
+    compare_type = "EQUAL_TO"
+    value        = "api.example.com"
+  }
+
+  rule {
+    type         = "HEADER"
+    compare_type = "EQUAL_TO"
+    key          = "X-Version"
+    value        = "2"
+    invert       = true
+  }
+}
+`
@@ -2,6 +2,7 @@ package lb
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -26,6 +27,8 @@ func ResourceListener() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceListenerCustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -105,6 +108,57 @@ func ResourceListener() *schema.Resource {
 				Description: "A list of references to Keymanager Secrets containers which store SNI information.",
 			},
 
+			"tls_versions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(lbTLSVersions, false),
+				},
+				Description: "A list of TLS protocol versions accepted from clients. Available versions: `SSLv3`, `TLSv1`, `TLSv1.1`, `TLSv1.2`, `TLSv1.3`. Only applicable to `TERMINATED_HTTPS` listeners.",
+			},
+
+			"tls_ciphers": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "A colon-separated list of ciphers in OpenSSL format accepted from clients. Only applicable to `TERMINATED_HTTPS` listeners.",
+			},
+
+			"alpn_protocols": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(lbListenerALPNProtocols, false),
+				},
+				Description: "A list of ALPN protocols to negotiate with clients in order of preference. Available protocols: `h2`, `http/1.1`, `http/1.0`. Only applicable to `TERMINATED_HTTPS` listeners.",
+			},
+
+			"client_authentication": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(lbListenerClientAuthentications, false),
+				Description:  "The TLS client authentication mode. Must be one of `NONE`, `OPTIONAL`, `MANDATORY`. `OPTIONAL` and `MANDATORY` require `client_ca_tls_container_ref`. Only applicable to `TERMINATED_HTTPS` listeners.",
+			},
+
+			"client_ca_tls_container_ref": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateLBKeyManagerRef("containers", "secrets"),
+				Description:  "A reference to a Keymanager secret or container with the CA certificate used to verify client certificates. Only applicable to `TERMINATED_HTTPS` listeners.",
+			},
+
+			"client_crl_container_ref": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateLBKeyManagerRef("containers", "secrets"),
+				Description:  "A reference to a Keymanager secret or container with the certificate revocation list for client certificates. Requires `client_ca_tls_container_ref`. Only applicable to `TERMINATED_HTTPS` listeners.",
+			},
+
 			"admin_state_up": {
 				Type:        schema.TypeBool,
 				Default:     true,
@@ -185,16 +239,30 @@ func resourceListenerCreate(ctx context.Context, d *schema.ResourceData, meta in
 	}
 
 	var createOpts listeners.CreateOptsBuilder
-	opts := listeners.CreateOpts{
-		Protocol:               listeners.Protocol(d.Get("protocol").(string)),
-		ProtocolPort:           d.Get("protocol_port").(int),
-		LoadbalancerID:         d.Get("loadbalancer_id").(string),
-		Name:                   d.Get("name").(string),
-		DefaultPoolID:          d.Get("default_pool_id").(string),
-		Description:            d.Get("description").(string),
-		DefaultTlsContainerRef: d.Get("default_tls_container_ref").(string),
-		SniContainerRefs:       sniContainerRefs,
-		AdminStateUp:           &adminStateUp,
+	opts := ilisteners.ListenerCreateOpts{
+		CreateOpts: listeners.CreateOpts{
+			Protocol:               listeners.Protocol(d.Get("protocol").(string)),
+			ProtocolPort:           d.Get("protocol_port").(int),
+			LoadbalancerID:         d.Get("loadbalancer_id").(string),
+			Name:                   d.Get("name").(string),
+			DefaultPoolID:          d.Get("default_pool_id").(string),
+			Description:            d.Get("description").(string),
+			DefaultTlsContainerRef: d.Get("default_tls_container_ref").(string),
+			SniContainerRefs:       sniContainerRefs,
+			AdminStateUp:           &adminStateUp,
+		},
+		TLSCiphers:              d.Get("tls_ciphers").(string),
+		ClientAuthentication:    d.Get("client_authentication").(string),
+		ClientCATLSContainerRef: d.Get("client_ca_tls_container_ref").(string),
+		ClientCRLContainerRef:   d.Get("client_crl_container_ref").(string),
+	}
+
+	if raw, ok := d.GetOk("tls_versions"); ok {
+		opts.TLSVersions = expandLBListenerTLSVersions(raw.([]interface{}))
+	}
+
+	if raw, ok := d.GetOk("alpn_protocols"); ok {
+		opts.ALPNProtocols = util.ExpandToStringSlice(raw.([]interface{}))
 	}
 
 	if v, ok := d.GetOk("connection_limit"); ok {
@@ -270,7 +338,8 @@ func resourceListenerRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return diag.Errorf("Error creating VKCS loadbalancer client: %s", err)
 	}
 
-	listener, err := ilisteners.Get(lbClient, d.Id()).Extract()
+	var listener listenerExtended
+	err = ilisteners.ExtractListenerInto(ilisteners.Get(lbClient, d.Id()), &listener)
 	if err != nil {
 		return diag.FromErr(util.CheckDeleted(d, err, "vkcs_lb_listener"))
 	}
@@ -290,6 +359,12 @@ func resourceListenerRead(ctx context.Context, d *schema.ResourceData, meta inte
 	d.Set("timeout_tcp_inspect", listener.TimeoutTCPInspect)
 	d.Set("sni_container_refs", listener.SniContainerRefs)
 	d.Set("default_tls_container_ref", listener.DefaultTlsContainerRef)
+	d.Set("tls_versions", listener.TLSVersions)
+	d.Set("tls_ciphers", listener.TLSCiphers)
+	d.Set("alpn_protocols", listener.ALPNProtocols)
+	d.Set("client_authentication", listener.ClientAuthentication)
+	d.Set("client_ca_tls_container_ref", listener.ClientCATLSContainerRef)
+	d.Set("client_crl_container_ref", listener.ClientCRLContainerRef)
 	d.Set("allowed_cidrs", listener.AllowedCIDRs)
 	d.Set("region", util.GetRegion(d, config))
 
@@ -326,7 +401,7 @@ func resourceListenerUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		return diag.FromErr(err)
 	}
 	var hasChange bool
-	var opts listeners.UpdateOpts
+	var opts ilisteners.ListenerUpdateOpts
 	if d.HasChange("name") {
 		hasChange = true
 		name := d.Get("name").(string)
@@ -392,6 +467,42 @@ func resourceListenerUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		opts.SniContainerRefs = &sniContainerRefs
 	}
 
+	if d.HasChange("tls_versions") {
+		hasChange = true
+		tlsVersions := expandLBListenerTLSVersions(d.Get("tls_versions").([]interface{}))
+		opts.TLSVersions = &tlsVersions
+	}
+
+	if d.HasChange("tls_ciphers") {
+		hasChange = true
+		tlsCiphers := d.Get("tls_ciphers").(string)
+		opts.TLSCiphers = &tlsCiphers
+	}
+
+	if d.HasChange("alpn_protocols") {
+		hasChange = true
+		alpnProtocols := util.ExpandToStringSlice(d.Get("alpn_protocols").([]interface{}))
+		opts.ALPNProtocols = &alpnProtocols
+	}
+
+	if d.HasChange("client_authentication") {
+		hasChange = true
+		clientAuthentication := d.Get("client_authentication").(string)
+		opts.ClientAuthentication = &clientAuthentication
+	}
+
+	if d.HasChange("client_ca_tls_container_ref") {
+		hasChange = true
+		clientCATLSContainerRef := d.Get("client_ca_tls_container_ref").(string)
+		opts.ClientCATLSContainerRef = &clientCATLSContainerRef
+	}
+
+	if d.HasChange("client_crl_container_ref") {
+		hasChange = true
+		clientCRLContainerRef := d.Get("client_crl_container_ref").(string)
+		opts.ClientCRLContainerRef = &clientCRLContainerRef
+	}
+
 	if d.HasChange("admin_state_up") {
 		hasChange = true
 		asu := d.Get("admin_state_up").(bool)
@@ -483,3 +594,27 @@ func resourceListenerDelete(ctx context.Context, d *schema.ResourceData, meta in
 
 	return nil
 }
+
+func resourceListenerCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.NewValueKnown("protocol") && diff.Get("protocol").(string) != "TERMINATED_HTTPS" {
+		rawConfig := diff.GetRawConfig()
+		for _, k := range lbListenerTLSAttributes {
+			if v := rawConfig.GetAttr(k); !v.IsNull() {
+				return fmt.Errorf("%q can only be set for listeners with \"TERMINATED_HTTPS\" protocol", k)
+			}
+		}
+	}
+
+	switch diff.Get("client_authentication").(string) {
+	case "OPTIONAL", "MANDATORY":
+		if diff.Get("client_ca_tls_container_ref").(string) == "" {
+			return fmt.Errorf("\"client_authentication\" %q requires \"client_ca_tls_container_ref\" to be set", diff.Get("client_authentication").(string))
+		}
+	}
+
+	if diff.Get("client_crl_container_ref").(string) != "" && diff.Get("client_ca_tls_container_ref").(string) == "" {
+		return fmt.Errorf("\"client_crl_container_ref\" requires \"client_ca_tls_container_ref\" to be set")
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package lb_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -244,6 +245,23 @@ func TestAccLBListener_allowedCidrsOrderIgnored(t *testing.T) {
 	})
 }
 
+func TestAccLBListener_tlsValidation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: acctest.AccTestProviders,
+		CheckDestroy:      testAccCheckLBListenerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      fmt.Sprintf(testAccLBListenerConfigTLS, "HTTP", "NONE", `client_ca_tls_container_ref = "https://public.infra.mail.ru:9311/v1/secrets/0f1f4c3a-3b1e-4a1f-9d5c-2a6b7c8d9e0f"`),
+				ExpectError: regexp.MustCompile("can only be set for listeners with \"TERMINATED_HTTPS\" protocol"),
+			},
+			{
+				Config:      fmt.Sprintf(testAccLBListenerConfigTLS, "TERMINATED_HTTPS", "MANDATORY", ""),
+				ExpectError: regexp.MustCompile("requires \"client_ca_tls_container_ref\" to be set"),
+			},
+		},
+	})
+}
+
 func testAccCheckLBListenerDestroy(s *terraform.State) error {
 	config := acctest.AccTestProvider.Meta().(clients.Config)
 	lbClient, err := config.LoadBalancerV2Client(acctest.OsRegionName)
@@ -591,3 +609,14 @@ resource "vkcs_lb_listener" "listener_1" {
   allowed_cidrs   = ["192.168.2.0/24", "192.168.1.0/24"]
 }
 `
+
+const testAccLBListenerConfigTLS = `
+resource "vkcs_lb_listener" "listener_1" {
+  name                  = "listener_1"
+  protocol              = "%s"
+  protocol_port         = 443
+  loadbalancer_id       = "0f1f4c3a-3b1e-4a1f-9d5c-2a6b7c8d9e0f"
+  client_authentication = "%s"
+  %s
+}
+`
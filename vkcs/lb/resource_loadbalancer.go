@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/clients"
 	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/util"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/networking"
 
 	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/loadbalancers"
 	iloadbalancers "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/lb/v2/loadbalancers"
@@ -25,6 +26,12 @@ func ResourceLoadBalancer() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: networking.CheckSDNConsistency(
+			networking.SDNObject{Key: "vip_network_id", Type: networking.SDNObjectNetwork},
+			networking.SDNObject{Key: "vip_subnet_id", Type: networking.SDNObjectSubnet},
+			networking.SDNObject{Key: "vip_port_id", Type: networking.SDNObjectPort},
+		),
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -98,6 +105,20 @@ func ResourceLoadBalancer() *schema.Resource {
 				Description: "The availability zone of the Loadbalancer. Changing this creates a new loadbalancer.",
 			},
 
+			"flavor_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Computed:    true,
+				Description: "The ID of the Loadbalancer flavor, which defines its sizing. See `vkcs_lb_flavors` data source for available flavors. If omitted, the default flavor is used. Changing this creates a new loadbalancer.",
+			},
+
+			"failover_trigger": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "An arbitrary string. Setting this argument to a new non-empty value triggers a failover of the Loadbalancer, which recreates its amphorae. Failover is not triggered when the Loadbalancer is created.",
+			},
+
 			"security_group_ids": {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -147,6 +168,10 @@ func resourceLoadBalancerCreate(ctx context.Context, d *schema.ResourceData, met
 		createOpts.AvailabilityZone = aZ
 	}
 
+	if v, ok := d.GetOk("flavor_id"); ok {
+		createOpts.FlavorID = v.(string)
+	}
+
 	if v, ok := d.GetOk("tags"); ok {
 		tags := v.(*schema.Set).List()
 		createOpts.Tags = util.ExpandToStringSlice(tags)
@@ -193,6 +218,7 @@ func resourceLoadBalancerRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set("vip_address", lb.VipAddress)
 	d.Set("admin_state_up", lb.AdminStateUp)
 	d.Set("availability_zone", lb.AvailabilityZone)
+	d.Set("flavor_id", lb.FlavorID)
 	d.Set("region", util.GetRegion(d, config))
 	d.Set("tags", lb.Tags)
 
@@ -262,6 +288,34 @@ func resourceLoadBalancerUpdate(ctx context.Context, d *schema.ResourceData, met
 		}
 	}
 
+	if d.HasChange("failover_trigger") && d.Get("failover_trigger").(string) != "" {
+		// Wait for load-balancer to become active before continuing.
+		timeout := d.Timeout(schema.TimeoutUpdate)
+		err = waitForLBLoadBalancer(ctx, lbClient, d.Id(), "ACTIVE", getLbPendingStatuses(), timeout)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		log.Printf("[DEBUG] Triggering failover of vkcs_lb_loadbalancer %s", d.Id())
+		err = retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+			err = iloadbalancers.Failover(lbClient, d.Id()).ExtractErr()
+			if err != nil {
+				return util.CheckForRetryableError(err)
+			}
+			return nil
+		})
+
+		if err != nil {
+			return diag.Errorf("Error triggering failover of vkcs_lb_loadbalancer %s: %s", d.Id(), err)
+		}
+
+		// Wait for load-balancer to become active after failover.
+		err = waitForLBLoadBalancer(ctx, lbClient, d.Id(), "ACTIVE", getLbPendingStatuses(), timeout)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceLoadBalancerRead(ctx, d, meta)
 }
 
@@ -78,6 +78,31 @@ func TestAccLBLoadBalancer_vip_port_id(t *testing.T) {
 	})
 }
 
+func TestAccLBLoadBalancer_flavorFailover(t *testing.T) {
+	var lb loadbalancers.LoadBalancer
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: acctest.AccTestProviders,
+		CheckDestroy:      testAccCheckLBLoadBalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccLbLoadBalancerConfigFlavorFailover, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBLoadBalancerExists("vkcs_lb_loadbalancer.loadbalancer_1", &lb),
+					resource.TestCheckResourceAttrPair("vkcs_lb_loadbalancer.loadbalancer_1", "flavor_id", "data.vkcs_lb_flavors.flavors", "flavors.0.id"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccLbLoadBalancerConfigFlavorFailover, "failover-1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBLoadBalancerExists("vkcs_lb_loadbalancer.loadbalancer_1", &lb),
+					resource.TestCheckResourceAttr("vkcs_lb_loadbalancer.loadbalancer_1", "failover_trigger", "failover-1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckLBLoadBalancerDestroy(s *terraform.State) error {
 	config := acctest.AccTestProvider.Meta().(clients.Config)
 	lbClient, err := config.LoadBalancerV2Client(acctest.OsRegionName)
@@ -304,3 +329,30 @@ resource "vkcs_lb_loadbalancer" "loadbalancer_1" {
   }
 }
 `
+
+const testAccLbLoadBalancerConfigFlavorFailover = `
+    resource "vkcs_networking_network" "network_1" {
+      name = "network_1"
+      admin_state_up = "true"
+    }
+
+    resource "vkcs_networking_subnet" "subnet_1" {
+      name = "subnet_1"
+      cidr = "192.168.199.0/24"
+      network_id = vkcs_networking_network.network_1.id
+    }
+
+    data "vkcs_lb_flavors" "flavors" {}
+
+    resource "vkcs_lb_loadbalancer" "loadbalancer_1" {
+      name = "loadbalancer_1"
+      vip_subnet_id = vkcs_networking_subnet.subnet_1.id
+      flavor_id = data.vkcs_lb_flavors.flavors.flavors[0].id
+      failover_trigger = "%s"
+
+      timeouts {
+        create = "15m"
+        update = "15m"
+        delete = "15m"
+      }
+    }`
@@ -92,6 +92,21 @@ func ResourceMember() *schema.Resource {
 				ForceNew:    true,
 				Description: "The id of the pool that this member will be assigned to. Changing this creates a new member.",
 			},
+
+			"drain": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to drain the member before removal: set its weight to 0 and wait for `drain_timeout` before deleting it. Defaults to false.",
+			},
+
+			"drain_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      lbMemberDrainTimeoutDefault,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The time in seconds to wait for connections to drain. Connections are not tracked per member, so the whole time is waited. The wait counts towards the delete or update timeout of the resource. Defaults to 300.",
+			},
 		},
 		Description: "Manages a member resource within VKCS.",
 	}
@@ -199,6 +214,11 @@ func resourceMemberUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		return diag.Errorf("Error creating VKCS networking client: %s", err)
 	}
 
+	// Drain settings are used only on delete and are not sent to the API.
+	if !d.HasChanges("name", "weight", "admin_state_up") {
+		return resourceMemberRead(ctx, d, meta)
+	}
+
 	var updateOpts pools.UpdateMemberOpts
 	if d.HasChange("name") {
 		name := d.Get("name").(string)
@@ -288,6 +308,37 @@ func resourceMemberDelete(ctx context.Context, d *schema.ResourceData, meta inte
 		return diag.FromErr(util.CheckDeleted(d, err, "Error waiting for the members pool status"))
 	}
 
+	if d.Get("drain").(bool) {
+		weight := 0
+		drainOpts := pools.UpdateMemberOpts{
+			Weight: &weight,
+		}
+
+		log.Printf("[DEBUG] Draining member %s", d.Id())
+		err = retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+			_, err = ipools.UpdateMember(lbClient, poolID, d.Id(), drainOpts).Extract()
+			if err != nil {
+				return util.CheckForRetryableError(err)
+			}
+			return nil
+		})
+
+		if err != nil {
+			return diag.FromErr(util.CheckDeleted(d, err, "Error draining member"))
+		}
+
+		err = waitForLBPool(ctx, lbClient, parentPool, "ACTIVE", getLbPendingStatuses(), timeout)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		drainTimeout := time.Duration(d.Get("drain_timeout").(int)) * time.Second
+		err = waitForLBPoolMembersDrain(ctx, parentPool, drainTimeout)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	log.Printf("[DEBUG] Attempting to delete member %s", d.Id())
 	err = retry.RetryContext(ctx, timeout, func() *retry.RetryError {
 		err = ipools.DeleteMember(lbClient, poolID, d.Id()).ExtractErr()
@@ -322,6 +373,7 @@ func resourceMemberImport(d *schema.ResourceData, meta interface{}) ([]*schema.R
 
 	d.SetId(memberID)
 	d.Set("pool_id", poolID)
+	setLBMembersDrainDefaults(d)
 
 	return []*schema.ResourceData{d}, nil
 }
@@ -6,6 +6,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/gophercloud/gophercloud"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -24,7 +25,7 @@ func ResourceMembers() *schema.Resource {
 		UpdateContext: resourceMembersUpdate,
 		DeleteContext: resourceMembersDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceMembersImport,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -109,6 +110,21 @@ func ResourceMembers() *schema.Resource {
 				},
 				Description: "A set of dictionaries containing member parameters. The structure is described below.",
 			},
+
+			"drain": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to drain members before removal: set their weight to 0 and wait for `drain_timeout` before deleting them. Defaults to false.",
+			},
+
+			"drain_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      lbMemberDrainTimeoutDefault,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The time in seconds to wait for connections to drain. Connections are not tracked per member, so the whole time is waited. The wait counts towards the delete or update timeout of the resource. Defaults to 300.",
+			},
 		},
 	}
 }
@@ -210,6 +226,18 @@ func resourceMembersUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			return diag.FromErr(err)
 		}
 
+		o, n := d.GetChange("member")
+		removed := lbMembersRemoved(o.(*schema.Set), n.(*schema.Set))
+		if d.Get("drain").(bool) && removed.Len() > 0 {
+			// Apply new members and keep removed ones with zero weight until they are drained.
+			drainOpts := append(expandLBMembers(n.(*schema.Set), lbClient), expandLBMembersDrain(removed, lbClient)...)
+			drainTimeout := time.Duration(d.Get("drain_timeout").(int)) * time.Second
+			err = resourceMembersDrain(ctx, lbClient, parentPool, drainOpts, timeout, drainTimeout)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
 		log.Printf("[DEBUG] Updating %s pool members with options: %#v", d.Id(), updateOpts)
 		err = retry.RetryContext(ctx, timeout, func() *retry.RetryError {
 			err = ipools.BatchUpdateMembers(lbClient, d.Id(), updateOpts).ExtractErr()
@@ -253,6 +281,15 @@ func resourceMembersDelete(ctx context.Context, d *schema.ResourceData, meta int
 		return diag.FromErr(util.CheckDeleted(d, err, "Error waiting for the members' pool status"))
 	}
 
+	if members := d.Get("member").(*schema.Set); d.Get("drain").(bool) && members.Len() > 0 {
+		drainOpts := expandLBMembersDrain(members, lbClient)
+		drainTimeout := time.Duration(d.Get("drain_timeout").(int)) * time.Second
+		err = resourceMembersDrain(ctx, lbClient, parentPool, drainOpts, timeout, drainTimeout)
+		if err != nil {
+			return diag.FromErr(util.CheckDeleted(d, err, "Error draining members"))
+		}
+	}
+
 	log.Printf("[DEBUG] Attempting to delete %s pool members", d.Id())
 	err = retry.RetryContext(ctx, timeout, func() *retry.RetryError {
 		err = ipools.BatchUpdateMembers(lbClient, d.Id(), []octaviapools.BatchUpdateMemberOpts{}).ExtractErr()
@@ -274,3 +311,32 @@ func resourceMembersDelete(ctx context.Context, d *schema.ResourceData, meta int
 
 	return nil
 }
+
+// resourceMembersDrain updates pool members with opts, which set zero weight
+// for members to be removed, and waits for their connections to drain.
+func resourceMembersDrain(ctx context.Context, lbClient *gophercloud.ServiceClient, parentPool *octaviapools.Pool, opts []octaviapools.BatchUpdateMemberOpts, timeout, drainTimeout time.Duration) error {
+	log.Printf("[DEBUG] Draining %s pool members with options: %#v", parentPool.ID, opts)
+	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		err := ipools.BatchUpdateMembers(lbClient, parentPool.ID, opts).ExtractErr()
+		if err != nil {
+			return util.CheckForRetryableError(err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("error draining %s pool members: %s", parentPool.ID, err)
+	}
+
+	err = waitForLBPool(ctx, lbClient, parentPool, "ACTIVE", getLbPendingStatuses(), timeout)
+	if err != nil {
+		return err
+	}
+
+	return waitForLBPoolMembersDrain(ctx, parentPool, drainTimeout)
+}
+
+func resourceMembersImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	setLBMembersDrainDefaults(d)
+	return []*schema.ResourceData{d}, nil
+}
@@ -109,6 +109,54 @@ func TestAccLBMembers_basic(t *testing.T) {
 	})
 }
 
+func TestAccLBMembers_drain(t *testing.T) {
+	var members []pools.Member
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: acctest.AccTestProviders,
+		CheckDestroy:      testAccCheckLBMembersDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(TestAccLbMembersConfigDrain, `
+  member {
+    address = "192.168.199.110"
+    protocol_port = 8080
+    subnet_id = vkcs_networking_subnet.subnet_1.id
+  }
+
+  member {
+    address = "192.168.199.111"
+    protocol_port = 8080
+    subnet_id = vkcs_networking_subnet.subnet_1.id
+  }`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBMembersExists("vkcs_lb_members.members_1", &members),
+					resource.TestCheckResourceAttr("vkcs_lb_members.members_1", "member.#", "2"),
+					resource.TestCheckResourceAttr("vkcs_lb_members.members_1", "drain", "true"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(TestAccLbMembersConfigDrain, `
+  member {
+    address = "192.168.199.111"
+    protocol_port = 8080
+    subnet_id = vkcs_networking_subnet.subnet_1.id
+  }
+
+  member {
+    address = "192.168.199.112"
+    protocol_port = 8080
+    subnet_id = vkcs_networking_subnet.subnet_1.id
+  }`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBMembersExists("vkcs_lb_members.members_1", &members),
+					resource.TestCheckResourceAttr("vkcs_lb_members.members_1", "member.#", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckLBMembersDestroy(s *terraform.State) error {
 	config := acctest.AccTestProvider.Meta().(clients.Config)
 	lbClient, err := config.LoadBalancerV2Client(acctest.OsRegionName)
@@ -393,3 +441,48 @@ resource "vkcs_lb_members" "members_1" {
   }
 }
 `
+
+const TestAccLbMembersConfigDrain = `
+resource "vkcs_networking_network" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "vkcs_networking_subnet" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  network_id = vkcs_networking_network.network_1.id
+}
+
+resource "vkcs_lb_loadbalancer" "loadbalancer_1" {
+  name = "loadbalancer_1"
+  vip_subnet_id = vkcs_networking_subnet.subnet_1.id
+}
+
+resource "vkcs_lb_listener" "listener_1" {
+  name = "listener_1"
+  protocol = "HTTP"
+  protocol_port = 8080
+  loadbalancer_id = vkcs_lb_loadbalancer.loadbalancer_1.id
+}
+
+resource "vkcs_lb_pool" "pool_1" {
+  name = "pool_1"
+  protocol = "HTTP"
+  lb_method = "ROUND_ROBIN"
+  listener_id = vkcs_lb_listener.listener_1.id
+}
+
+resource "vkcs_lb_members" "members_1" {
+  pool_id = vkcs_lb_pool.pool_1.id
+  drain = true
+  drain_timeout = 30
+%s
+
+  timeouts {
+    create = "10m"
+    update = "10m"
+    delete = "10m"
+  }
+}
+`
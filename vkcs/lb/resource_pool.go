@@ -28,6 +28,8 @@ func ResourcePool() *schema.Resource {
 			StateContext: resourcePoolImport,
 		},
 
+		CustomizeDiff: resourcePoolCustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -125,6 +127,52 @@ func ResourcePool() *schema.Resource {
 				Optional:    true,
 				Description: "The administrative state of the pool. A valid value is true (UP) or false (DOWN).",
 			},
+
+			"tls_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "When true, connections to backend member servers will use TLS encryption. Default is false.",
+			},
+
+			"tls_container_ref": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateLBKeyManagerRef("containers"),
+				Description:  "A reference to a Keymanager Secrets container (`container_ref` of `vkcs_keymanager_container`) which stores the client certificate and key presented to backend member servers. Requires `tls_enabled` to be true.",
+			},
+
+			"ca_tls_container_ref": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateLBKeyManagerRef("containers", "secrets"),
+				Description:  "A reference to a Keymanager object which stores the CA certificate bundle used to verify certificates of backend member servers. Requires `tls_enabled` to be true.",
+			},
+
+			"crl_container_ref": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateLBKeyManagerRef("containers", "secrets"),
+				Description:  "A reference to a Keymanager object which stores the certificate revocation list used to verify certificates of backend member servers. Requires `ca_tls_container_ref` to be set.",
+			},
+
+			"tls_versions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(lbTLSVersions, false),
+				},
+				Description: "A list of TLS protocol versions used for connections to backend member servers. Available versions: SSLv3, TLSv1, TLSv1.1, TLSv1.2, TLSv1.3. If omitted, the default versions of the load balancer are used.",
+			},
+
+			"tls_ciphers": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "List of ciphers in OpenSSL format (colon-separated) used for connections to backend member servers. If omitted, the default ciphers of the load balancer are used.",
+			},
 		},
 		Description: "Manages a pool resource within VKCS.",
 	}
@@ -175,7 +223,20 @@ func resourcePoolCreate(ctx context.Context, d *schema.ResourceData, meta interf
 		createOpts.Persistence = &persistence
 	}
 
-	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	poolCreateOpts := ipools.PoolCreateOpts{
+		CreateOpts:        createOpts,
+		TLSEnabled:        d.Get("tls_enabled").(bool),
+		TLSContainerRef:   d.Get("tls_container_ref").(string),
+		CATLSContainerRef: d.Get("ca_tls_container_ref").(string),
+		CRLContainerRef:   d.Get("crl_container_ref").(string),
+		TLSCiphers:        d.Get("tls_ciphers").(string),
+	}
+
+	if v, ok := d.GetOk("tls_versions"); ok {
+		poolCreateOpts.TLSVersions = util.ExpandToStringSlice(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", poolCreateOpts)
 
 	timeout := d.Timeout(schema.TimeoutCreate)
 
@@ -202,7 +263,7 @@ func resourcePoolCreate(ctx context.Context, d *schema.ResourceData, meta interf
 	log.Printf("[DEBUG] Attempting to create pool")
 	var pool *pools.Pool
 	err = retry.RetryContext(ctx, timeout, func() *retry.RetryError {
-		pool, err = ipools.Create(lbClient, createOpts).Extract()
+		pool, err = ipools.Create(lbClient, poolCreateOpts).Extract()
 		if err != nil {
 			return util.CheckForRetryableError(err)
 		}
@@ -232,7 +293,8 @@ func resourcePoolRead(ctx context.Context, d *schema.ResourceData, meta interfac
 		return diag.Errorf("Error creating VKCS loadbalancer client: %s", err)
 	}
 
-	pool, err := ipools.Get(lbClient, d.Id()).Extract()
+	var pool poolExtended
+	err = ipools.ExtractPoolInto(ipools.Get(lbClient, d.Id()), &pool)
 	if err != nil {
 		return diag.FromErr(util.CheckDeleted(d, err, "pool"))
 	}
@@ -245,6 +307,12 @@ func resourcePoolRead(ctx context.Context, d *schema.ResourceData, meta interfac
 	d.Set("admin_state_up", pool.AdminStateUp)
 	d.Set("name", pool.Name)
 	d.Set("persistence", flattenLBPoolPersistence(pool.Persistence))
+	d.Set("tls_enabled", pool.TLSEnabled)
+	d.Set("tls_container_ref", pool.TLSContainerRef)
+	d.Set("ca_tls_container_ref", pool.CATLSContainerRef)
+	d.Set("crl_container_ref", pool.CRLContainerRef)
+	d.Set("tls_versions", pool.TLSVersions)
+	d.Set("tls_ciphers", pool.TLSCiphers)
 	d.Set("region", util.GetRegion(d, config))
 
 	return nil
@@ -257,7 +325,7 @@ func resourcePoolUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 		return diag.Errorf("Error creating VKCS loadbalancer client: %s", err)
 	}
 
-	var updateOpts pools.UpdateOpts
+	var updateOpts ipools.PoolUpdateOpts
 	if d.HasChange("lb_method") {
 		updateOpts.LBMethod = pools.LBMethod(d.Get("lb_method").(string))
 	}
@@ -273,6 +341,30 @@ func resourcePoolUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 		asu := d.Get("admin_state_up").(bool)
 		updateOpts.AdminStateUp = &asu
 	}
+	if d.HasChange("tls_enabled") {
+		tlsEnabled := d.Get("tls_enabled").(bool)
+		updateOpts.TLSEnabled = &tlsEnabled
+	}
+	if d.HasChange("tls_container_ref") {
+		tlsContainerRef := d.Get("tls_container_ref").(string)
+		updateOpts.TLSContainerRef = &tlsContainerRef
+	}
+	if d.HasChange("ca_tls_container_ref") {
+		caTLSContainerRef := d.Get("ca_tls_container_ref").(string)
+		updateOpts.CATLSContainerRef = &caTLSContainerRef
+	}
+	if d.HasChange("crl_container_ref") {
+		crlContainerRef := d.Get("crl_container_ref").(string)
+		updateOpts.CRLContainerRef = &crlContainerRef
+	}
+	if d.HasChange("tls_versions") {
+		tlsVersions := util.ExpandToStringSlice(d.Get("tls_versions").([]interface{}))
+		updateOpts.TLSVersions = &tlsVersions
+	}
+	if d.HasChange("tls_ciphers") {
+		tlsCiphers := d.Get("tls_ciphers").(string)
+		updateOpts.TLSCiphers = &tlsCiphers
+	}
 
 	timeout := d.Timeout(schema.TimeoutUpdate)
 
@@ -372,3 +464,19 @@ func resourcePoolImport(ctx context.Context, d *schema.ResourceData, meta interf
 
 	return []*schema.ResourceData{d}, nil
 }
+
+func resourcePoolCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.Get("tls_enabled").(bool) {
+		for _, k := range []string{"tls_container_ref", "ca_tls_container_ref", "crl_container_ref"} {
+			if diff.Get(k).(string) != "" {
+				return fmt.Errorf("%q requires \"tls_enabled\" to be true", k)
+			}
+		}
+	}
+
+	if diff.Get("crl_container_ref").(string) != "" && diff.Get("ca_tls_container_ref").(string) == "" {
+		return fmt.Errorf("\"crl_container_ref\" requires \"ca_tls_container_ref\" to be set")
+	}
+
+	return nil
+}
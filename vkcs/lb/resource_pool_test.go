@@ -2,6 +2,7 @@ package lb_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -53,6 +54,23 @@ func TestAccLBPool_octavia_udp(t *testing.T) {
 	})
 }
 
+func TestAccLBPool_tlsValidation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: acctest.AccTestProviders,
+		CheckDestroy:      testAccCheckLBPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      fmt.Sprintf(TestAccLbPoolConfigTLS, "https://public.infra.mail.ru:9311/v1/secrets/0f1f4c3a-3b1e-4a1f-9d5c-2a6b7c8d9e0f", "true"),
+				ExpectError: regexp.MustCompile("Keymanager containers"),
+			},
+			{
+				Config:      fmt.Sprintf(TestAccLbPoolConfigTLS, "https://public.infra.mail.ru:9311/v1/containers/0f1f4c3a-3b1e-4a1f-9d5c-2a6b7c8d9e0f", "false"),
+				ExpectError: regexp.MustCompile("requires \"tls_enabled\" to be true"),
+			},
+		},
+	})
+}
+
 func testAccCheckLBPoolDestroy(s *terraform.State) error {
 	config := acctest.AccTestProvider.Meta().(clients.Config)
 	lbClient, err := config.LoadBalancerV2Client(acctest.OsRegionName)
@@ -231,3 +249,14 @@ resource "vkcs_lb_pool" "pool_1" {
   }
 }
 `
+
+const TestAccLbPoolConfigTLS = `
+resource "vkcs_lb_pool" "pool_1" {
+  name = "pool_1"
+  protocol = "HTTP"
+  lb_method = "ROUND_ROBIN"
+  loadbalancer_id = "0f1f4c3a-3b1e-4a1f-9d5c-2a6b7c8d9e0f"
+  tls_container_ref = "%s"
+  tls_enabled = %s
+}
+`
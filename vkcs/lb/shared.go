@@ -4,8 +4,11 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/url"
+	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/util"
@@ -32,6 +35,17 @@ const (
 	lbError         = "ERROR"
 )
 
+// lbMemberDrainTimeoutDefault is the default time in seconds to wait for
+// connections of members to drain.
+const lbMemberDrainTimeoutDefault = 300
+
+// setLBMembersDrainDefaults sets default drain settings of imported members,
+// since they are not stored in the API.
+func setLBMembersDrainDefaults(d *schema.ResourceData) {
+	d.Set("drain", false)
+	d.Set("drain_timeout", lbMemberDrainTimeoutDefault)
+}
+
 // lbPendingStatuses are the valid statuses a LoadBalancer will be in while
 // it's updating.
 func getLbPendingStatuses() []string {
@@ -316,6 +330,25 @@ func resourceLBPoolRefreshFunc(lbClient *gophercloud.ServiceClient, lbID string,
 	return resourceLBLoadBalancerStatusRefreshFuncNeutron(lbClient, lbID, "pool", pool.ID, "")
 }
 
+// waitForLBPoolMembersDrain waits for connections of draining members of the
+// pool to complete. The loadbalancer does not report connections per member,
+// so the wait is a fixed delay of the given duration.
+func waitForLBPoolMembersDrain(ctx context.Context, pool *pools.Pool, delay time.Duration) error {
+	if len(pool.Listeners) == 0 || delay <= 0 {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Waiting %s for draining members of pool %s", delay, pool.ID)
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("error waiting for draining members of pool %s: %s", pool.ID, ctx.Err())
+	case <-time.After(delay):
+	}
+
+	return nil
+}
+
 func lbFindLBIDviaPool(lbClient *gophercloud.ServiceClient, pool *pools.Pool) (string, error) {
 	if len(pool.Loadbalancers) > 0 {
 		return pool.Loadbalancers[0].ID, nil
@@ -579,6 +612,66 @@ func waitForLBL7Rule(ctx context.Context, lbClient *gophercloud.ServiceClient, p
 	return nil
 }
 
+// lbTLSVersions are TLS protocol versions supported by pools and listeners.
+var lbTLSVersions = []string{"SSLv3", "TLSv1", "TLSv1.1", "TLSv1.2", "TLSv1.3"}
+
+// lbListenerALPNProtocols are ALPN protocols supported by listeners.
+var lbListenerALPNProtocols = []string{"h2", "http/1.1", "http/1.0"}
+
+// lbListenerClientAuthentications are TLS client authentication modes of listeners.
+var lbListenerClientAuthentications = []string{"NONE", "OPTIONAL", "MANDATORY"}
+
+// lbListenerTLSAttributes are listener attributes that only apply to
+// TERMINATED_HTTPS listeners.
+var lbListenerTLSAttributes = []string{
+	"tls_versions", "tls_ciphers", "alpn_protocols",
+	"client_authentication", "client_ca_tls_container_ref", "client_crl_container_ref",
+}
+
+func expandLBListenerTLSVersions(raw []interface{}) []listeners.TLSVersion {
+	tlsVersions := make([]listeners.TLSVersion, len(raw))
+	for i, v := range raw {
+		tlsVersions[i] = listeners.TLSVersion(v.(string))
+	}
+	return tlsVersions
+}
+
+// validateLBKeyManagerRef returns a validation function, which checks that
+// the value is a reference to a Keymanager object of one of the kinds, e.g.
+// https://{barbican_host}/v1/containers/{container_uuid}.
+func validateLBKeyManagerRef(kinds ...string) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errs []error) {
+		ref := v.(string)
+		u, err := url.Parse(ref)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			errs = append(errs, fmt.Errorf("expected %q to be a Keymanager reference URL, got: %s", k, ref))
+			return
+		}
+
+		parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+		if len(parts) < 2 || !util.StrSliceContains(kinds, parts[len(parts)-2]) {
+			errs = append(errs, fmt.Errorf("expected %q to be a reference to Keymanager %s, got: %s", k, strings.Join(kinds, " or "), ref))
+			return
+		}
+
+		if _, err := uuid.Parse(parts[len(parts)-1]); err != nil {
+			errs = append(errs, fmt.Errorf("expected %q to end with a Keymanager object UUID, got: %s", k, ref))
+		}
+
+		return
+	}
+}
+
+type listenerExtended struct {
+	listeners.Listener
+	ilisteners.ListenerTLSExt
+}
+
+type poolExtended struct {
+	pools.Pool
+	ipools.PoolTLSExt
+}
+
 func flattenLBPoolPersistence(p pools.SessionPersistence) []map[string]interface{} {
 	return []map[string]interface{}{
 		{
@@ -607,6 +700,39 @@ func FlattenLBMembers(members []pools.Member) []map[string]interface{} {
 	return m
 }
 
+// lbMembersRemoved returns members of the old set, which have no member with
+// the same address and port in the new set.
+func lbMembersRemoved(oldMembers, newMembers *schema.Set) *schema.Set {
+	kept := make(map[string]bool)
+	for _, raw := range newMembers.List() {
+		kept[lbMemberKey(raw.(map[string]interface{}))] = true
+	}
+
+	removed := schema.NewSet(oldMembers.F, nil)
+	for _, raw := range oldMembers.List() {
+		if !kept[lbMemberKey(raw.(map[string]interface{}))] {
+			removed.Add(raw)
+		}
+	}
+
+	return removed
+}
+
+func lbMemberKey(member map[string]interface{}) string {
+	return fmt.Sprintf("%s:%d", member["address"].(string), member["protocol_port"].(int))
+}
+
+// expandLBMembersDrain returns batch update options, which keep members of
+// the set in the pool with zero weight, so they receive no new connections.
+func expandLBMembersDrain(members *schema.Set, lbClient *gophercloud.ServiceClient) []pools.BatchUpdateMemberOpts {
+	m := expandLBMembers(members, lbClient)
+	for i := range m {
+		weight := 0
+		m[i].Weight = &weight
+	}
+	return m
+}
+
 func expandLBMembers(members *schema.Set, lbClient *gophercloud.ServiceClient) []pools.BatchUpdateMemberOpts {
 	var m []pools.BatchUpdateMemberOpts
 
@@ -639,3 +765,191 @@ func expandLBMembers(members *schema.Set, lbClient *gophercloud.ServiceClient) [
 
 	return m
 }
+
+// lbStatusTree represents the operating status tree of a loadbalancer.
+type lbStatusTree struct {
+	Loadbalancer lbLoadBalancerStatus `json:"loadbalancer"`
+}
+
+type lbLoadBalancerStatus struct {
+	ID                 string             `json:"id"`
+	Name               string             `json:"name"`
+	OperatingStatus    string             `json:"operating_status"`
+	ProvisioningStatus string             `json:"provisioning_status"`
+	Listeners          []lbListenerStatus `json:"listeners"`
+}
+
+type lbListenerStatus struct {
+	ID                 string         `json:"id"`
+	Name               string         `json:"name"`
+	OperatingStatus    string         `json:"operating_status"`
+	ProvisioningStatus string         `json:"provisioning_status"`
+	Pools              []lbPoolStatus `json:"pools"`
+}
+
+type lbPoolStatus struct {
+	ID                 string           `json:"id"`
+	Name               string           `json:"name"`
+	OperatingStatus    string           `json:"operating_status"`
+	ProvisioningStatus string           `json:"provisioning_status"`
+	Members            []lbMemberStatus `json:"members"`
+}
+
+type lbMemberStatus struct {
+	ID                 string `json:"id"`
+	Name               string `json:"name"`
+	Address            string `json:"address"`
+	ProtocolPort       int    `json:"protocol_port"`
+	OperatingStatus    string `json:"operating_status"`
+	ProvisioningStatus string `json:"provisioning_status"`
+}
+
+func flattenLBListenerStatuses(statuses []lbListenerStatus) []map[string]interface{} {
+	l := make([]map[string]interface{}, len(statuses))
+	for i, listener := range statuses {
+		l[i] = map[string]interface{}{
+			"id":                  listener.ID,
+			"name":                listener.Name,
+			"operating_status":    listener.OperatingStatus,
+			"provisioning_status": listener.ProvisioningStatus,
+			"pools":               flattenLBPoolStatuses(listener.Pools),
+		}
+	}
+	return l
+}
+
+func flattenLBPoolStatuses(statuses []lbPoolStatus) []map[string]interface{} {
+	p := make([]map[string]interface{}, len(statuses))
+	for i, pool := range statuses {
+		p[i] = map[string]interface{}{
+			"id":                  pool.ID,
+			"name":                pool.Name,
+			"operating_status":    pool.OperatingStatus,
+			"provisioning_status": pool.ProvisioningStatus,
+			"members":             flattenLBMemberStatuses(pool.Members),
+		}
+	}
+	return p
+}
+
+func flattenLBMemberStatuses(statuses []lbMemberStatus) []map[string]interface{} {
+	m := make([]map[string]interface{}, len(statuses))
+	for i, member := range statuses {
+		m[i] = map[string]interface{}{
+			"id":                  member.ID,
+			"name":                member.Name,
+			"address":             member.Address,
+			"protocol_port":       member.ProtocolPort,
+			"operating_status":    member.OperatingStatus,
+			"provisioning_status": member.ProvisioningStatus,
+		}
+	}
+	return m
+}
+
+func expandLBL7PolicyRules(rules *schema.Set) []l7policies.CreateRuleOpts {
+	var r []l7policies.CreateRuleOpts
+
+	for _, raw := range rules.List() {
+		rawMap := raw.(map[string]interface{})
+		r = append(r, l7policies.CreateRuleOpts{
+			RuleType:    l7policies.RuleType(rawMap["type"].(string)),
+			CompareType: l7policies.CompareType(rawMap["compare_type"].(string)),
+			Key:         rawMap["key"].(string),
+			Value:       rawMap["value"].(string),
+			Invert:      rawMap["invert"].(bool),
+		})
+	}
+
+	return r
+}
+
+func flattenLBL7PolicyRules(rules []l7policies.Rule) []map[string]interface{} {
+	r := make([]map[string]interface{}, len(rules))
+
+	for i, rule := range rules {
+		r[i] = map[string]interface{}{
+			"type":         rule.RuleType,
+			"compare_type": rule.CompareType,
+			"key":          rule.Key,
+			"value":        rule.Value,
+			"invert":       rule.Invert,
+		}
+	}
+
+	return r
+}
+
+func checkL7PolicyRules(rules *schema.Set) error {
+	for _, rule := range expandLBL7PolicyRules(rules) {
+		if err := checkL7RuleType(string(rule.RuleType), rule.Key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func lbL7RuleKey(ruleType, compareType, key, value string, invert bool) string {
+	return strings.Join([]string{ruleType, compareType, key, value, fmt.Sprint(invert)}, "/")
+}
+
+// lbL7PolicyRulesToDelete returns IDs of existing rules, which match rules of
+// the set. Each existing rule is matched at most once.
+func lbL7PolicyRulesToDelete(existing []l7policies.Rule, rules *schema.Set) []string {
+	ids := make(map[string][]string)
+	for _, rule := range existing {
+		k := lbL7RuleKey(rule.RuleType, rule.CompareType, rule.Key, rule.Value, rule.Invert)
+		ids[k] = append(ids[k], rule.ID)
+	}
+
+	var r []string
+	for _, rule := range expandLBL7PolicyRules(rules) {
+		k := lbL7RuleKey(string(rule.RuleType), string(rule.CompareType), rule.Key, rule.Value, rule.Invert)
+		if len(ids[k]) == 0 {
+			continue
+		}
+		r = append(r, ids[k][0])
+		ids[k] = ids[k][1:]
+	}
+
+	return r
+}
+
+// lbL7PolicyRulesUntracked returns existing rules, which do not match rules of
+// the set.
+func lbL7PolicyRulesUntracked(existing []l7policies.Rule, rules *schema.Set) []l7policies.Rule {
+	tracked := make(map[string]bool)
+	for _, id := range lbL7PolicyRulesToDelete(existing, rules) {
+		tracked[id] = true
+	}
+
+	var r []l7policies.Rule
+	for _, rule := range existing {
+		if !tracked[rule.ID] {
+			r = append(r, rule)
+		}
+	}
+
+	return r
+}
+
+// lbL7PolicyRulesToCreate returns create options of rules of the set, which
+// do not match existing rules. Each existing rule is matched at most once.
+func lbL7PolicyRulesToCreate(existing []l7policies.Rule, rules *schema.Set) []l7policies.CreateRuleOpts {
+	count := make(map[string]int)
+	for _, rule := range existing {
+		count[lbL7RuleKey(rule.RuleType, rule.CompareType, rule.Key, rule.Value, rule.Invert)]++
+	}
+
+	var r []l7policies.CreateRuleOpts
+	for _, rule := range expandLBL7PolicyRules(rules) {
+		k := lbL7RuleKey(string(rule.RuleType), string(rule.CompareType), rule.Key, rule.Value, rule.Invert)
+		if count[k] > 0 {
+			count[k]--
+			continue
+		}
+		r = append(r, rule)
+	}
+
+	return r
+}
@@ -3,6 +3,9 @@ package lb
 import (
 	"testing"
 
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/l7policies"
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/listeners"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -34,3 +37,179 @@ func TestExpandLBListenerHeadersMap_err(t *testing.T) {
 	assert.Error(t, err)
 	assert.Empty(t, actual)
 }
+
+func TestExpandLBListenerTLSVersions(t *testing.T) {
+	raw := []interface{}{"TLSv1.2", "TLSv1.3"}
+
+	expected := []listeners.TLSVersion{listeners.TLSVersionTLSv1_2, listeners.TLSVersionTLSv1_3}
+
+	actual := expandLBListenerTLSVersions(raw)
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestValidateLBKeyManagerRef(t *testing.T) {
+	validateContainer := validateLBKeyManagerRef("containers")
+	validateAny := validateLBKeyManagerRef("containers", "secrets")
+
+	containerRef := "https://public.infra.mail.ru:9311/v1/containers/0f1f4c3a-3b1e-4a1f-9d5c-2a6b7c8d9e0f"
+	secretRef := "https://public.infra.mail.ru:9311/v1/secrets/0f1f4c3a-3b1e-4a1f-9d5c-2a6b7c8d9e0f"
+
+	_, errs := validateContainer(containerRef, "tls_container_ref")
+	assert.Empty(t, errs)
+
+	_, errs = validateContainer(secretRef, "tls_container_ref")
+	assert.NotEmpty(t, errs)
+
+	_, errs = validateAny(secretRef, "ca_tls_container_ref")
+	assert.Empty(t, errs)
+
+	_, errs = validateContainer("0f1f4c3a-3b1e-4a1f-9d5c-2a6b7c8d9e0f", "tls_container_ref")
+	assert.NotEmpty(t, errs)
+
+	_, errs = validateContainer("https://public.infra.mail.ru:9311/v1/containers/foo", "tls_container_ref")
+	assert.NotEmpty(t, errs)
+}
+
+func TestFlattenLBListenerStatuses(t *testing.T) {
+	statuses := []lbListenerStatus{
+		{
+			ID:                 "listener_1",
+			Name:               "listener",
+			OperatingStatus:    "ONLINE",
+			ProvisioningStatus: "ACTIVE",
+			Pools: []lbPoolStatus{
+				{
+					ID:                 "pool_1",
+					Name:               "pool",
+					OperatingStatus:    "DEGRADED",
+					ProvisioningStatus: "ACTIVE",
+					Members: []lbMemberStatus{
+						{
+							ID:                 "member_1",
+							Address:            "192.168.199.10",
+							ProtocolPort:       8080,
+							OperatingStatus:    "ERROR",
+							ProvisioningStatus: "ACTIVE",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"id":                  "listener_1",
+			"name":                "listener",
+			"operating_status":    "ONLINE",
+			"provisioning_status": "ACTIVE",
+			"pools": []map[string]interface{}{
+				{
+					"id":                  "pool_1",
+					"name":                "pool",
+					"operating_status":    "DEGRADED",
+					"provisioning_status": "ACTIVE",
+					"members": []map[string]interface{}{
+						{
+							"id":                  "member_1",
+							"name":                "",
+							"address":             "192.168.199.10",
+							"protocol_port":       8080,
+							"operating_status":    "ERROR",
+							"provisioning_status": "ACTIVE",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, expected, flattenLBListenerStatuses(statuses))
+}
+
+func TestLBMembersRemoved(t *testing.T) {
+	f := schema.HashResource(ResourceMembers().Schema["member"].Elem.(*schema.Resource))
+	member := func(address string, port, weight int) map[string]interface{} {
+		return map[string]interface{}{
+			"id":             "",
+			"name":           "",
+			"address":        address,
+			"protocol_port":  port,
+			"weight":         weight,
+			"subnet_id":      "",
+			"backup":         false,
+			"admin_state_up": true,
+		}
+	}
+
+	oldMembers := schema.NewSet(f, []interface{}{
+		member("192.168.199.10", 8080, 1),
+		member("192.168.199.11", 8080, 1),
+		member("192.168.199.12", 8080, 1),
+	})
+	newMembers := schema.NewSet(f, []interface{}{
+		member("192.168.199.10", 8080, 5),
+		member("192.168.199.11", 8081, 1),
+		member("192.168.199.13", 8080, 1),
+	})
+
+	removed := lbMembersRemoved(oldMembers, newMembers)
+
+	expected := schema.NewSet(f, []interface{}{
+		member("192.168.199.11", 8080, 1),
+		member("192.168.199.12", 8080, 1),
+	})
+	assert.True(t, expected.Equal(removed))
+
+	drainOpts := expandLBMembersDrain(removed, nil)
+	assert.Len(t, drainOpts, 2)
+	for _, opts := range drainOpts {
+		assert.Equal(t, 0, *opts.Weight)
+	}
+}
+
+func TestLBL7PolicyRulesToDelete(t *testing.T) {
+	f := schema.HashResource(ResourceL7Policy().Schema["rule"].Elem.(*schema.Resource))
+	rule := func(ruleType, compareType, key, value string, invert bool) map[string]interface{} {
+		return map[string]interface{}{
+			"type":         ruleType,
+			"compare_type": compareType,
+			"key":          key,
+			"value":        value,
+			"invert":       invert,
+		}
+	}
+
+	existing := []l7policies.Rule{
+		{ID: "1", RuleType: "PATH", CompareType: "STARTS_WITH", Value: "/api"},
+		{ID: "2", RuleType: "HEADER", CompareType: "EQUAL_TO", Key: "X-Version", Value: "2", Invert: true},
+		{ID: "3", RuleType: "HOST_NAME", CompareType: "EQUAL_TO", Value: "example.com"},
+	}
+	removed := schema.NewSet(f, []interface{}{
+		rule("HEADER", "EQUAL_TO", "X-Version", "2", true),
+		rule("HOST_NAME", "EQUAL_TO", "", "example.com", false),
+		rule("PATH", "STARTS_WITH", "", "/static", false),
+	})
+
+	assert.ElementsMatch(t, []string{"2", "3"}, lbL7PolicyRulesToDelete(existing, removed))
+
+	tracked := schema.NewSet(f, []interface{}{
+		rule("PATH", "STARTS_WITH", "", "/api", false),
+	})
+	untracked := lbL7PolicyRulesUntracked(existing, tracked)
+	assert.Len(t, untracked, 2)
+	assert.Equal(t, "2", untracked[0].ID)
+
+	added := schema.NewSet(f, []interface{}{
+		rule("HOST_NAME", "EQUAL_TO", "", "example.com", false),
+		rule("PATH", "STARTS_WITH", "", "/static", false),
+	})
+	toCreate := lbL7PolicyRulesToCreate(untracked, added)
+	assert.Len(t, toCreate, 1)
+	assert.Equal(t, "/static", toCreate[0].Value)
+
+	flattened := flattenLBL7PolicyRules(existing)
+	assert.Len(t, flattened, 3)
+	assert.Equal(t, "X-Version", flattened[1]["key"])
+}
@@ -81,6 +81,12 @@ func DataSourceNetworkingNetwork() *schema.Resource {
 				Description: "Specifies whether the network resource can be accessed by any tenant or not.",
 			},
 
+			"shared_via_rbac": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Specifies whether the network is owned by another project and is shared with the current project via RBAC policy.",
+			},
+
 			"external": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -238,6 +244,7 @@ func dataSourceNetworkingNetworkRead(ctx context.Context, d *schema.ResourceData
 	d.Set("description", network.Description)
 	d.Set("admin_state_up", strconv.FormatBool(network.AdminStateUp))
 	d.Set("shared", strconv.FormatBool(network.Shared))
+	d.Set("shared_via_rbac", network.Shared && network.TenantID != config.GetProjectID())
 	d.Set("external", network.External)
 	d.Set("tenant_id", network.TenantID)
 	d.Set("subnets", network.Subnets)
@@ -25,6 +25,8 @@ func TestAccNetworkingNetworkDataSource_basic(t *testing.T) {
 						"data.vkcs_networking_network.network_1", "name", "tf_test_network"),
 					resource.TestCheckResourceAttr(
 						"data.vkcs_networking_network.network_1", "description", "my network description"),
+					resource.TestCheckResourceAttr(
+						"data.vkcs_networking_network.network_1", "shared_via_rbac", "false"),
 					resource.TestCheckResourceAttr(
 						"data.vkcs_networking_network.network_1", "admin_state_up", "true"),
 					resource.TestCheckResourceAttr(
@@ -0,0 +1,261 @@
+package networking
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/subnetpools"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/clients"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/framework/utils"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/networking"
+	isubnetpools "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/networking/v2/subnetpools"
+)
+
+var (
+	_ datasource.DataSource              = &SubnetPoolDataSource{}
+	_ datasource.DataSourceWithConfigure = &SubnetPoolDataSource{}
+)
+
+func NewSubnetPoolDataSource() datasource.DataSource {
+	return &SubnetPoolDataSource{}
+}
+
+type SubnetPoolDataSource struct {
+	config clients.Config
+}
+
+type SubnetPoolDataSourceModel struct {
+	SDN    types.String `tfsdk:"sdn"`
+	Region types.String `tfsdk:"region"`
+
+	AddressScopeID      types.String `tfsdk:"address_scope_id"`
+	AllTags             types.Set    `tfsdk:"all_tags"`
+	DefaultPrefixLength types.Int64  `tfsdk:"default_prefix_length"`
+	Description         types.String `tfsdk:"description"`
+	ID                  types.String `tfsdk:"id"`
+	MaxPrefixLength     types.Int64  `tfsdk:"max_prefix_length"`
+	MinPrefixLength     types.Int64  `tfsdk:"min_prefix_length"`
+	Name                types.String `tfsdk:"name"`
+	Prefixes            types.List   `tfsdk:"prefixes"`
+	ProjectID           types.String `tfsdk:"project_id"`
+	Shared              types.Bool   `tfsdk:"shared"`
+	Tags                types.Set    `tfsdk:"tags"`
+}
+
+func (d *SubnetPoolDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "vkcs_networking_subnetpool"
+}
+
+func (d *SubnetPoolDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"region": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The region in which to obtain the Network client. A Network client is needed to retrieve subnet pools. If omitted, the `region` argument of the provider is used.",
+			},
+
+			"sdn": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "SDN to use for this resource. Must be one of following: \"neutron\", \"sprut\". Default value is project's default SDN.",
+				Validators: []validator.String{
+					stringvalidator.OneOfCaseInsensitive("neutron", "sprut"),
+				},
+			},
+
+			"address_scope_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the address scope the subnet pool belongs to.",
+			},
+
+			"all_tags": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "A set of string tags applied on the subnet pool.",
+			},
+
+			"default_prefix_length": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The size of the prefix to allocate when the subnet prefix length is omitted.",
+			},
+
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Human-readable description of the subnet pool.",
+			},
+
+			"id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the subnet pool.",
+			},
+
+			"max_prefix_length": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The maximum prefix size that can be allocated from the subnet pool.",
+			},
+
+			"min_prefix_length": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The smallest prefix that can be allocated from the subnet pool.",
+			},
+
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the subnet pool.",
+			},
+
+			"prefixes": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "A list of subnet prefixes of the subnet pool.",
+			},
+
+			"project_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The owner of the subnet pool.",
+			},
+
+			"shared": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the subnet pool is shared across all projects.",
+			},
+
+			"tags": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "The list of subnet pool tags to filter.",
+			},
+		},
+		Description: "Use this data source to get the ID of an available VKCS subnet pool.",
+	}
+}
+
+func (d *SubnetPoolDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.config = req.ProviderData.(clients.Config)
+}
+
+func (d *SubnetPoolDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SubnetPoolDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	region := data.Region.ValueString()
+	if region == "" {
+		region = d.config.GetRegion()
+	}
+
+	sdn := data.SDN.ValueString()
+	if sdn == "" {
+		sdn = networking.SearchInAllSDNs
+	}
+
+	client, err := d.config.NetworkingV2Client(region, sdn)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating VKCS Networking API client", err.Error())
+		return
+	}
+
+	listOpts := subnetpools.ListOpts{
+		ID:               data.ID.ValueString(),
+		Name:             data.Name.ValueString(),
+		Description:      data.Description.ValueString(),
+		ProjectID:        data.ProjectID.ValueString(),
+		DefaultPrefixLen: int(data.DefaultPrefixLength.ValueInt64()),
+		MinPrefixLen:     int(data.MinPrefixLength.ValueInt64()),
+		MaxPrefixLen:     int(data.MaxPrefixLength.ValueInt64()),
+		AddressScopeID:   data.AddressScopeID.ValueString(),
+	}
+
+	if utils.IsKnown(data.Shared) {
+		listOpts.Shared = data.Shared.ValueBoolPointer()
+	}
+
+	listOpts.Tags = expandSubnetDataSourceTags(ctx, data.Tags, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Calling Networking API to list subnet pools", map[string]interface{}{"list_opts": fmt.Sprintf("%#v", listOpts)})
+
+	allPages, err := subnetpools.List(client, listOpts).AllPages()
+	if err != nil {
+		resp.Diagnostics.AddError("Error calling VKCS Networking API", err.Error())
+		return
+	}
+
+	var allSubnetPools []subnetPoolExtended
+	err = isubnetpools.ExtractSubnetPoolsInto(allPages, &allSubnetPools)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading VKCS Networking API response", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Called Networking API to list subnet pools", map[string]interface{}{"all_subnet_pools_len": len(allSubnetPools)})
+
+	if len(allSubnetPools) < 1 {
+		resp.Diagnostics.AddError("Your query returned no results",
+			"Please change your search criteria and try again")
+		return
+	}
+
+	if len(allSubnetPools) > 1 {
+		resp.Diagnostics.AddError("Your query returned more than one result",
+			"Please try a more specific search criteria")
+		return
+	}
+
+	subnetPool := allSubnetPools[0]
+	tflog.Debug(ctx, "Retrieved the subnet pool", map[string]interface{}{"subnet_pool": fmt.Sprintf("%#v", subnetPool)})
+
+	data.ID = types.StringValue(subnetPool.ID)
+	data.Region = types.StringValue(region)
+	data.SDN = types.StringValue(subnetPool.SDN)
+
+	data.AddressScopeID = types.StringValue(subnetPool.AddressScopeID)
+	data.AllTags = flattenSubnetDataSourceAllTags(ctx, subnetPool.Tags, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.DefaultPrefixLength = types.Int64Value(int64(subnetPool.DefaultPrefixLen))
+	data.Description = types.StringValue(subnetPool.Description)
+	data.MaxPrefixLength = types.Int64Value(int64(subnetPool.MaxPrefixLen))
+	data.MinPrefixLength = types.Int64Value(int64(subnetPool.MinPrefixLen))
+	data.Name = types.StringValue(subnetPool.Name)
+	data.Prefixes = flattenSubnetPoolDataSourcePrefixes(ctx, subnetPool.Prefixes, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.ProjectID = types.StringValue(subnetPool.ProjectID)
+	data.Shared = types.BoolValue(subnetPool.Shared)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func flattenSubnetPoolDataSourcePrefixes(ctx context.Context, in []string, respDiags *diag.Diagnostics) types.List {
+	r, diags := types.ListValueFrom(ctx, types.StringType, in)
+	respDiags.Append(diags...)
+	return r
+}
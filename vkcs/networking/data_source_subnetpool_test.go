@@ -0,0 +1,43 @@
+package networking_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/acctest"
+)
+
+func TestAccNetworkingSubnetPoolDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.AccTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingSubnetPoolDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.vkcs_networking_subnetpool.subnetpool_1", "id", "vkcs_networking_subnetpool.subnetpool_1", "id"),
+					resource.TestCheckResourceAttr("data.vkcs_networking_subnetpool.subnetpool_1", "prefixes.#", "1"),
+					resource.TestCheckResourceAttr("data.vkcs_networking_subnetpool.subnetpool_1", "prefixes.0", "10.30.0.0/16"),
+					resource.TestCheckResourceAttr("data.vkcs_networking_subnetpool.subnetpool_1", "default_prefix_length", "24"),
+					resource.TestCheckResourceAttr("data.vkcs_networking_subnetpool.subnetpool_1", "all_tags.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+const testAccNetworkingSubnetPoolDataSourceBasic = `
+resource "vkcs_networking_subnetpool" "subnetpool_1" {
+  name                  = "subnetpool_1"
+  prefixes              = ["10.30.0.0/16"]
+  default_prefix_length = 24
+  tags                  = ["tfacc"]
+  sdn                   = "neutron"
+}
+
+data "vkcs_networking_subnetpool" "subnetpool_1" {
+  name = vkcs_networking_subnetpool.subnetpool_1.name
+  tags = ["tfacc"]
+  sdn  = "neutron"
+}
+`
@@ -0,0 +1,141 @@
+package networking
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions"
+	iportforwarding "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/networking/v2/portforwarding"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/util/errutil"
+)
+
+const (
+	floatingIPPortForwardingExtension           = "floating-ip-port-forwarding"
+	floatingIPPortForwardingPortRangesExtension = "floating-ip-port-forwarding-port-ranges"
+)
+
+type floatingIPPortForwardingPortRange struct {
+	start int
+	end   int
+}
+
+func (r floatingIPPortForwardingPortRange) size() int {
+	return r.end - r.start + 1
+}
+
+func (r floatingIPPortForwardingPortRange) overlaps(other floatingIPPortForwardingPortRange) bool {
+	return r.start <= other.end && other.start <= r.end
+}
+
+func (r floatingIPPortForwardingPortRange) String() string {
+	if r.start == r.end {
+		return strconv.Itoa(r.start)
+	}
+	return fmt.Sprintf("%d:%d", r.start, r.end)
+}
+
+// parseFloatingIPPortForwardingPortRange parses a port range in the
+// <start>:<end> format.
+func parseFloatingIPPortForwardingPortRange(v string) (floatingIPPortForwardingPortRange, error) {
+	parts := strings.Split(v, ":")
+	if len(parts) != 2 {
+		return floatingIPPortForwardingPortRange{}, fmt.Errorf("invalid port range %q, expected <start>:<end>", v)
+	}
+
+	start, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return floatingIPPortForwardingPortRange{}, fmt.Errorf("invalid start of port range %q: %s", v, err)
+	}
+	end, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return floatingIPPortForwardingPortRange{}, fmt.Errorf("invalid end of port range %q: %s", v, err)
+	}
+
+	if start < 1 || end > 65535 || start >= end {
+		return floatingIPPortForwardingPortRange{}, fmt.Errorf("invalid port range %q, ports must be in 1-65535 and start must be less than end", v)
+	}
+
+	return floatingIPPortForwardingPortRange{start: start, end: end}, nil
+}
+
+// floatingIPPortForwardingExternalPorts returns external ports of a port
+// forwarding as a range.
+func floatingIPPortForwardingExternalPorts(port int, portRange string) (floatingIPPortForwardingPortRange, error) {
+	if portRange != "" {
+		return parseFloatingIPPortForwardingPortRange(portRange)
+	}
+	return floatingIPPortForwardingPortRange{start: port, end: port}, nil
+}
+
+func validateFloatingIPPortForwardingPortRange(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := parseFloatingIPPortForwardingPortRange(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%s: %s", k, err))
+	}
+	return
+}
+
+// checkFloatingIPPortForwardingExtension checks that the SDN the client is
+// bound to supports the port forwarding extension.
+func checkFloatingIPPortForwardingExtension(client *gophercloud.ServiceClient, alias, sdn string) error {
+	_, err := extensions.Get(client, alias).Extract()
+	if err != nil {
+		if errutil.IsNotFound(err) {
+			return fmt.Errorf("%q extension is not supported by %s SDN", alias, sdn)
+		}
+		return fmt.Errorf("error checking %q extension: %s", alias, err)
+	}
+	return nil
+}
+
+// checkFloatingIPPortForwardingOverlap checks that external ports do not
+// overlap with external ports of other port forwardings of the floating IP
+// with the same protocol.
+func checkFloatingIPPortForwardingOverlap(client *gophercloud.ServiceClient, floatingIPID, pfID, protocol string, externalPort int, externalPortRange string) error {
+	externalPorts, err := floatingIPPortForwardingExternalPorts(externalPort, externalPortRange)
+	if err != nil {
+		return err
+	}
+
+	allPages, err := iportforwarding.List(client, floatingIPID, nil).AllPages()
+	if err != nil {
+		log.Printf("[DEBUG] Unable to list port forwardings of vkcs_networking_floatingip %s, skipping overlap check: %s", floatingIPID, err)
+		return nil
+	}
+	allPortForwardings, err := iportforwarding.ExtractPortForwardings(allPages)
+	if err != nil {
+		return fmt.Errorf("error extracting port forwardings of vkcs_networking_floatingip %s: %s", floatingIPID, err)
+	}
+
+	for _, pf := range allPortForwardings {
+		if pf.ID == pfID || pf.Protocol != protocol {
+			continue
+		}
+		ports, err := floatingIPPortForwardingExternalPorts(pf.ExternalPort, pf.ExternalPortRange)
+		if err != nil {
+			log.Printf("[DEBUG] Unable to parse external ports of port forwarding %s: %s", pf.ID, err)
+			continue
+		}
+		if ports.overlaps(externalPorts) {
+			return fmt.Errorf("external ports %s overlap with external ports %s of %s port forwarding %s of floating IP %s",
+				externalPorts, ports, protocol, pf.ID, floatingIPID)
+		}
+	}
+
+	return nil
+}
+
+func resourceNetworkingFloatingIPPortForwardingBuildID(floatingIPID, portForwardingID string) string {
+	return fmt.Sprintf("%s/%s", floatingIPID, portForwardingID)
+}
+
+func resourceNetworkingFloatingIPPortForwardingParseID(id string) (string, string, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid ID format: %s, expected <floatingip_id>/<port_forwarding_id>", id)
+	}
+
+	return parts[0], parts[1], nil
+}
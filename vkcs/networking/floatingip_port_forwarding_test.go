@@ -0,0 +1,62 @@
+package networking
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	iportforwarding "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/networking/v2/portforwarding"
+)
+
+func TestParseFloatingIPPortForwardingPortRange(t *testing.T) {
+	r, err := parseFloatingIPPortForwardingPortRange("8000:8100")
+
+	assert.NoError(t, err)
+	assert.Equal(t, floatingIPPortForwardingPortRange{start: 8000, end: 8100}, r)
+	assert.Equal(t, 101, r.size())
+	assert.Equal(t, "8000:8100", r.String())
+
+	for _, v := range []string{"8000", "8100:8000", "8000:8000", "0:10", "1:65536", "a:b", "1:2:3"} {
+		_, err := parseFloatingIPPortForwardingPortRange(v)
+		assert.Error(t, err, v)
+	}
+}
+
+func TestFloatingIPPortForwardingPortRangeOverlaps(t *testing.T) {
+	single := floatingIPPortForwardingPortRange{start: 80, end: 80}
+
+	assert.True(t, single.overlaps(floatingIPPortForwardingPortRange{start: 80, end: 80}))
+	assert.True(t, single.overlaps(floatingIPPortForwardingPortRange{start: 1, end: 80}))
+	assert.True(t, single.overlaps(floatingIPPortForwardingPortRange{start: 80, end: 100}))
+	assert.False(t, single.overlaps(floatingIPPortForwardingPortRange{start: 81, end: 100}))
+	assert.False(t, single.overlaps(floatingIPPortForwardingPortRange{start: 1, end: 79}))
+	assert.Equal(t, "80", single.String())
+}
+
+func TestResourceNetworkingFloatingIPPortForwardingParseID(t *testing.T) {
+	fipID, pfID, err := resourceNetworkingFloatingIPPortForwardingParseID("d190e837-090a-44f2-adcf-07f9fd392931/9e1cbc0d-f2f4-4d7c-8b3a-e7a8d1e52b5d")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "d190e837-090a-44f2-adcf-07f9fd392931", fipID)
+	assert.Equal(t, "9e1cbc0d-f2f4-4d7c-8b3a-e7a8d1e52b5d", pfID)
+
+	_, _, err = resourceNetworkingFloatingIPPortForwardingParseID("d190e837-090a-44f2-adcf-07f9fd392931")
+	assert.Error(t, err)
+}
+
+func TestFloatingIPPortForwardingUpdateOptsClearPort(t *testing.T) {
+	externalPort := 0
+	externalPortRange := "8000:8100"
+	opts := iportforwarding.UpdateOpts{
+		ExternalPort:      &externalPort,
+		ExternalPortRange: &externalPortRange,
+	}
+
+	b, err := opts.ToPortForwardingUpdateMap()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"port_forwarding": map[string]interface{}{
+			"external_port":       nil,
+			"external_port_range": "8000:8100",
+		},
+	}, b)
+}
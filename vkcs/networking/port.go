@@ -118,7 +118,35 @@ func flattenNetworkingPortDHCPOpts(dhcpOpts extradhcpopts.ExtraDHCPOptsExt) []ma
 	return dhcpOptsSet
 }
 
-func expandNetworkingPortAllowedAddressPairs(allowedAddressPairs *schema.Set) []ports.AddressPair {
+// NetworkingPortAllowedAddressPairsSchema returns schema of allowed address
+// pairs of a port. It is shared with vkcs_compute_instance networks.
+func NetworkingPortAllowedAddressPairsSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		ForceNew: false,
+		Set:      resourceNetworkingPortAllowedAddressPairsHash,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"ip_address": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The additional IP address.",
+				},
+				"mac_address": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The additional MAC address.",
+				},
+			},
+		},
+		Description: description,
+	}
+}
+
+// ExpandNetworkingPortAllowedAddressPairs converts allowed address pairs of
+// the schema to address pairs of a port.
+func ExpandNetworkingPortAllowedAddressPairs(allowedAddressPairs *schema.Set) []ports.AddressPair {
 	rawPairs := allowedAddressPairs.List()
 
 	pairs := make([]ports.AddressPair, len(rawPairs))
@@ -133,7 +161,9 @@ func expandNetworkingPortAllowedAddressPairs(allowedAddressPairs *schema.Set) []
 	return pairs
 }
 
-func flattenNetworkingPortAllowedAddressPairs(mac string, allowedAddressPairs []ports.AddressPair) []map[string]interface{} {
+// FlattenNetworkingPortAllowedAddressPairs converts address pairs of a port
+// with the given MAC address to allowed address pairs of the schema.
+func FlattenNetworkingPortAllowedAddressPairs(mac string, allowedAddressPairs []ports.AddressPair) []map[string]interface{} {
 	pairs := make([]map[string]interface{}, len(allowedAddressPairs))
 
 	for i, pair := range allowedAddressPairs {
@@ -190,7 +190,7 @@ func TestExpandNetworkingPortAllowedAddressPairs(t *testing.T) {
 		},
 	}
 
-	actualAllowedAddressPairs := expandNetworkingPortAllowedAddressPairs(d.Get("allowed_address_pairs").(*schema.Set))
+	actualAllowedAddressPairs := ExpandNetworkingPortAllowedAddressPairs(d.Get("allowed_address_pairs").(*schema.Set))
 
 	assert.ElementsMatch(t, expectedAllowedAddressPairs, actualAllowedAddressPairs)
 }
@@ -219,7 +219,7 @@ func TestFlattenNetworkingPortAllowedAddressPairs(t *testing.T) {
 		},
 	}
 
-	actualAllowedAddressPairs := flattenNetworkingPortAllowedAddressPairs(mac, allowedAddressPairs)
+	actualAllowedAddressPairs := FlattenNetworkingPortAllowedAddressPairs(mac, allowedAddressPairs)
 
 	assert.ElementsMatch(t, expectedAllowedAddressPairs, actualAllowedAddressPairs)
 }
@@ -0,0 +1,49 @@
+package networking
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/qos/policies"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/networking"
+)
+
+// qosDSCPMarks are the valid DSCP mark values of a QoS DSCP marking rule.
+var qosDSCPMarks = []int{0, 8, 10, 12, 14, 16, 18, 20, 22, 24, 26, 28, 30, 32, 34, 36, 38, 40, 46, 48, 56}
+
+type qosPolicyExtended struct {
+	policies.Policy
+	networking.SDNExt
+}
+
+func resourceNetworkingQoSRuleBuildID(policyID, ruleID string) string {
+	return fmt.Sprintf("%s/%s", policyID, ruleID)
+}
+
+func resourceNetworkingQoSRuleParseID(id string) (string, string, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid ID format: %s, expected <policy_id>/<rule_id>", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+// resourceNetworkingQoSPolicyIDCustomizeDiff plans detaching of a QoS policy
+// when qos_policy_id is explicitly set to an empty string. The argument is
+// computed, so an empty string would otherwise be treated as unset.
+func resourceNetworkingQoSPolicyIDCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	v := diff.GetRawConfig().GetAttr("qos_policy_id")
+	if v.IsNull() || !v.IsKnown() || v.AsString() != "" {
+		return nil
+	}
+	if o, _ := diff.GetChange("qos_policy_id"); o.(string) == "" {
+		return nil
+	}
+	return diff.SetNew("qos_policy_id", "")
+}
@@ -0,0 +1,33 @@
+package networking
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceNetworkingQoSRuleBuildID(t *testing.T) {
+	expected := "d190e837-090a-44f2-adcf-07f9fd392931/9e1cbc0d-f2f4-4d7c-8b3a-e7a8d1e52b5d"
+
+	actual := resourceNetworkingQoSRuleBuildID(
+		"d190e837-090a-44f2-adcf-07f9fd392931",
+		"9e1cbc0d-f2f4-4d7c-8b3a-e7a8d1e52b5d",
+	)
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestResourceNetworkingQoSRuleParseValidID(t *testing.T) {
+	actualPolicyID, actualRuleID, err := resourceNetworkingQoSRuleParseID("d190e837-090a-44f2-adcf-07f9fd392931/9e1cbc0d-f2f4-4d7c-8b3a-e7a8d1e52b5d")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "d190e837-090a-44f2-adcf-07f9fd392931", actualPolicyID)
+	assert.Equal(t, "9e1cbc0d-f2f4-4d7c-8b3a-e7a8d1e52b5d", actualRuleID)
+}
+
+func TestResourceNetworkingQoSRuleParseInvalidID(t *testing.T) {
+	for _, id := range []string{"d190e837-090a-44f2-adcf-07f9fd392931", "d190e837-090a-44f2-adcf-07f9fd392931/", "a/b/c"} {
+		_, _, err := resourceNetworkingQoSRuleParseID(id)
+		assert.Error(t, err, id)
+	}
+}
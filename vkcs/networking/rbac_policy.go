@@ -0,0 +1,33 @@
+package networking
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/rbacpolicies"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/networking"
+)
+
+var rbacPolicyObjectTypes = []string{
+	"network",
+	"qos_policy",
+	"security_group",
+}
+
+var rbacPolicyActions = []string{
+	string(rbacpolicies.ActionAccessShared),
+	string(rbacpolicies.ActionAccessExternal),
+}
+
+type rbacPolicyExtended struct {
+	rbacpolicies.RBACPolicy
+	networking.SDNExt
+}
+
+// networkingRBACPolicyValidateAction checks that the action can be applied
+// to the object type.
+func networkingRBACPolicyValidateAction(objectType, action string) error {
+	if action == string(rbacpolicies.ActionAccessExternal) && objectType != "network" {
+		return fmt.Errorf("action %q is only supported for \"network\" object type, got %q", action, objectType)
+	}
+	return nil
+}
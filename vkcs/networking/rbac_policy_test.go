@@ -0,0 +1,17 @@
+package networking
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetworkingRBACPolicyValidateAction(t *testing.T) {
+	for _, objectType := range rbacPolicyObjectTypes {
+		assert.NoError(t, networkingRBACPolicyValidateAction(objectType, "access_as_shared"), objectType)
+	}
+
+	assert.NoError(t, networkingRBACPolicyValidateAction("network", "access_as_external"))
+	assert.Error(t, networkingRBACPolicyValidateAction("qos_policy", "access_as_external"))
+	assert.Error(t, networkingRBACPolicyValidateAction("security_group", "access_as_external"))
+}
@@ -24,6 +24,11 @@ func ResourceNetworkingFloatingIPAssociate() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: CheckSDNConsistency(
+			SDNObject{Key: "floating_ip", Type: SDNObjectFloatingIPAddress},
+			SDNObject{Key: "port_id", Type: SDNObjectPort},
+		),
+
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:        schema.TypeString,
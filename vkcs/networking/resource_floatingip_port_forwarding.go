@@ -0,0 +1,339 @@
+package networking
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/clients"
+	inetworking "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/networking"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/util"
+
+	ifloatingips "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/networking/v2/floatingips"
+	iportforwarding "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/networking/v2/portforwarding"
+)
+
+func ResourceNetworkingFloatingIPPortForwarding() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetworkingFloatingIPPortForwardingCreate,
+		ReadContext:   resourceNetworkingFloatingIPPortForwardingRead,
+		UpdateContext: resourceNetworkingFloatingIPPortForwardingUpdate,
+		DeleteContext: resourceNetworkingFloatingIPPortForwardingDelete,
+		CustomizeDiff: resourceNetworkingFloatingIPPortForwardingCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The region in which to obtain the networking client. A networking client is needed to create a port forwarding. If omitted, the `region` argument of the provider is used. Changing this creates a new port forwarding.",
+			},
+
+			"floatingip_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the floating IP. Changing this creates a new port forwarding.",
+			},
+
+			"protocol": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"tcp", "udp"}, false),
+				Description:  "The IP protocol of the port forwarding. Must be one of \"tcp\", \"udp\".",
+			},
+
+			"external_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IsPortNumber,
+				ExactlyOneOf: []string{"external_port", "external_port_range"},
+				Description:  "The port of the floating IP. Conflicts with `external_port_range`.",
+			},
+
+			"external_port_range": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateFloatingIPPortForwardingPortRange,
+				Description:  "The port range of the floating IP in the `<start>:<end>` format, e.g. `8000:8100`. Conflicts with `external_port`.",
+			},
+
+			"internal_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IsPortNumber,
+				ExactlyOneOf: []string{"internal_port", "internal_port_range"},
+				Description:  "The port of the internal IP address. Conflicts with `internal_port_range`.",
+			},
+
+			"internal_port_range": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateFloatingIPPortForwardingPortRange,
+				Description:  "The port range of the internal IP address in the `<start>:<end>` format. Must have the same size as `external_port_range`. Conflicts with `internal_port`.",
+			},
+
+			"internal_ip_address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsIPv4Address,
+				Description:  "The fixed IPv4 address of the internal port the traffic is forwarded to.",
+			},
+
+			"internal_port_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the port which has `internal_ip_address`.",
+			},
+
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Human-readable description of the port forwarding.",
+			},
+
+			"sdn": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Computed:         true,
+				ValidateDiagFunc: ValidateSDN(),
+				Description:      "SDN to use for this resource. Must be one of following: \"neutron\", \"sprut\". Default value is project's default SDN.",
+			},
+		},
+		Description: "Manages a port forwarding of a floating IP within VKCS. " +
+			"Port forwarding allows to expose several internal hosts behind a single floating IP.\n" +
+			"_note_ The floating IP must not be associated with a port. Port forwarding is available only in SDNs " +
+			"that provide the `floating-ip-port-forwarding` networking extension, port ranges additionally require " +
+			"the `floating-ip-port-forwarding-port-ranges` extension.",
+	}
+}
+
+func resourceNetworkingFloatingIPPortForwardingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(clients.Config)
+	networkingClient, err := config.NetworkingV2Client(util.GetRegion(d, config), GetSDN(d))
+	if err != nil {
+		return diag.Errorf("Error creating VKCS networking client: %s", err)
+	}
+
+	floatingIPID := d.Get("floatingip_id").(string)
+
+	sdn := GetSDN(d)
+	if sdn == inetworking.SearchInAllSDNs {
+		sdn = "the default"
+	}
+	if err := checkFloatingIPPortForwardingExtension(networkingClient, floatingIPPortForwardingExtension, sdn); err != nil {
+		return diag.FromErr(err)
+	}
+	if d.Get("external_port_range").(string) != "" {
+		if err := checkFloatingIPPortForwardingExtension(networkingClient, floatingIPPortForwardingPortRangesExtension, sdn); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	err = checkFloatingIPPortForwardingOverlap(networkingClient, floatingIPID, "", d.Get("protocol").(string),
+		d.Get("external_port").(int), d.Get("external_port_range").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	createOpts := iportforwarding.CreateOpts{
+		InternalPortID:    d.Get("internal_port_id").(string),
+		InternalIPAddress: d.Get("internal_ip_address").(string),
+		InternalPort:      d.Get("internal_port").(int),
+		InternalPortRange: d.Get("internal_port_range").(string),
+		ExternalPort:      d.Get("external_port").(int),
+		ExternalPortRange: d.Get("external_port_range").(string),
+		Protocol:          d.Get("protocol").(string),
+		Description:       d.Get("description").(string),
+	}
+
+	log.Printf("[DEBUG] vkcs_networking_floatingip_port_forwarding create options: %#v", createOpts)
+
+	var pf iportforwarding.PortForwarding
+	err = iportforwarding.ExtractPortForwardingInto(iportforwarding.Create(networkingClient, floatingIPID, createOpts).Result, &pf)
+	if err != nil {
+		return diag.Errorf("Error creating vkcs_networking_floatingip_port_forwarding: %s", err)
+	}
+
+	d.SetId(resourceNetworkingFloatingIPPortForwardingBuildID(floatingIPID, pf.ID))
+
+	log.Printf("[DEBUG] Created vkcs_networking_floatingip_port_forwarding %s: %#v", d.Id(), pf)
+	return resourceNetworkingFloatingIPPortForwardingRead(ctx, d, meta)
+}
+
+func resourceNetworkingFloatingIPPortForwardingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(clients.Config)
+	networkingClient, err := config.NetworkingV2Client(util.GetRegion(d, config), inetworking.SearchInAllSDNs)
+	if err != nil {
+		return diag.Errorf("Error creating VKCS networking client: %s", err)
+	}
+
+	floatingIPID, pfID, err := resourceNetworkingFloatingIPPortForwardingParseID(d.Id())
+	if err != nil {
+		return diag.Errorf("Error reading vkcs_networking_floatingip_port_forwarding ID %s: %s", d.Id(), err)
+	}
+
+	var pf iportforwarding.PortForwarding
+	err = iportforwarding.ExtractPortForwardingInto(iportforwarding.Get(networkingClient, floatingIPID, pfID).Result, &pf)
+	if err != nil {
+		return diag.FromErr(util.CheckDeleted(d, err, "Error getting vkcs_networking_floatingip_port_forwarding"))
+	}
+
+	log.Printf("[DEBUG] Retrieved vkcs_networking_floatingip_port_forwarding %s: %#v", d.Id(), pf)
+
+	var fip floatingIPExtended
+	err = ifloatingips.Get(networkingClient, floatingIPID).ExtractInto(&fip)
+	if err != nil {
+		return diag.FromErr(util.CheckDeleted(d, err, "Error getting vkcs_networking_floatingip"))
+	}
+
+	d.Set("floatingip_id", floatingIPID)
+	d.Set("protocol", pf.Protocol)
+	d.Set("internal_ip_address", pf.InternalIPAddress)
+	d.Set("internal_port_id", pf.InternalPortID)
+	d.Set("description", pf.Description)
+	d.Set("region", util.GetRegion(d, config))
+	d.Set("sdn", fip.SDN)
+
+	if pf.ExternalPort != 0 {
+		d.Set("external_port", pf.ExternalPort)
+		d.Set("external_port_range", "")
+	} else {
+		d.Set("external_port", nil)
+		d.Set("external_port_range", pf.ExternalPortRange)
+	}
+
+	if pf.InternalPort != 0 {
+		d.Set("internal_port", pf.InternalPort)
+		d.Set("internal_port_range", "")
+	} else {
+		d.Set("internal_port", nil)
+		d.Set("internal_port_range", pf.InternalPortRange)
+	}
+
+	return nil
+}
+
+func resourceNetworkingFloatingIPPortForwardingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(clients.Config)
+	networkingClient, err := config.NetworkingV2Client(util.GetRegion(d, config), GetSDN(d))
+	if err != nil {
+		return diag.Errorf("Error creating VKCS networking client: %s", err)
+	}
+
+	floatingIPID, pfID, err := resourceNetworkingFloatingIPPortForwardingParseID(d.Id())
+	if err != nil {
+		return diag.Errorf("Error reading vkcs_networking_floatingip_port_forwarding ID %s: %s", d.Id(), err)
+	}
+
+	var hasChange bool
+	var updateOpts iportforwarding.UpdateOpts
+
+	if d.HasChange("protocol") {
+		hasChange = true
+		updateOpts.Protocol = d.Get("protocol").(string)
+	}
+
+	if d.HasChanges("protocol", "external_port", "external_port_range") {
+		err = checkFloatingIPPortForwardingOverlap(networkingClient, floatingIPID, pfID, d.Get("protocol").(string),
+			d.Get("external_port").(int), d.Get("external_port_range").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// A port and a port range replace each other, so both are sent to clear
+	// the replaced one.
+	if d.HasChanges("external_port", "external_port_range") {
+		hasChange = true
+		externalPort := d.Get("external_port").(int)
+		externalPortRange := d.Get("external_port_range").(string)
+		updateOpts.ExternalPort = &externalPort
+		updateOpts.ExternalPortRange = &externalPortRange
+	}
+
+	if d.HasChanges("internal_port", "internal_port_range") {
+		hasChange = true
+		internalPort := d.Get("internal_port").(int)
+		internalPortRange := d.Get("internal_port_range").(string)
+		updateOpts.InternalPort = &internalPort
+		updateOpts.InternalPortRange = &internalPortRange
+	}
+
+	if d.HasChanges("internal_ip_address", "internal_port_id") {
+		hasChange = true
+		updateOpts.InternalIPAddress = d.Get("internal_ip_address").(string)
+		updateOpts.InternalPortID = d.Get("internal_port_id").(string)
+	}
+
+	if d.HasChange("description") {
+		hasChange = true
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] vkcs_networking_floatingip_port_forwarding %s update options: %#v", d.Id(), updateOpts)
+		_, err = iportforwarding.Update(networkingClient, floatingIPID, pfID, updateOpts).Extract()
+		if err != nil {
+			return diag.Errorf("Error updating vkcs_networking_floatingip_port_forwarding %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceNetworkingFloatingIPPortForwardingRead(ctx, d, meta)
+}
+
+func resourceNetworkingFloatingIPPortForwardingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(clients.Config)
+	networkingClient, err := config.NetworkingV2Client(util.GetRegion(d, config), GetSDN(d))
+	if err != nil {
+		return diag.Errorf("Error creating VKCS networking client: %s", err)
+	}
+
+	floatingIPID, pfID, err := resourceNetworkingFloatingIPPortForwardingParseID(d.Id())
+	if err != nil {
+		return diag.Errorf("Error reading vkcs_networking_floatingip_port_forwarding ID %s: %s", d.Id(), err)
+	}
+
+	if err := iportforwarding.Delete(networkingClient, floatingIPID, pfID).ExtractErr(); err != nil {
+		return diag.FromErr(util.CheckDeleted(d, err, "Error deleting vkcs_networking_floatingip_port_forwarding"))
+	}
+
+	return nil
+}
+
+func resourceNetworkingFloatingIPPortForwardingCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	for _, k := range []string{"external_port", "external_port_range", "internal_port_range"} {
+		if !diff.NewValueKnown(k) {
+			return nil
+		}
+	}
+
+	externalPorts, err := floatingIPPortForwardingExternalPorts(diff.Get("external_port").(int), diff.Get("external_port_range").(string))
+	if err != nil {
+		return err
+	}
+
+	internalPortRange := diff.Get("internal_port_range").(string)
+	if internalPortRange != "" {
+		internalPorts, err := parseFloatingIPPortForwardingPortRange(internalPortRange)
+		if err != nil {
+			return err
+		}
+		if internalPorts.size() != externalPorts.size() {
+			return fmt.Errorf("internal_port_range %s must have the same size as external port range %s", internalPorts, externalPorts)
+		}
+	} else if externalPorts.size() > 1 {
+		return fmt.Errorf("internal_port_range must be set when external_port_range is set")
+	}
+
+	return nil
+}
@@ -0,0 +1,141 @@
+package networking_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/portforwarding"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/acctest"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/clients"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/networking"
+)
+
+func TestAccNetworkingFloatingIPPortForwarding_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.AccTestPreCheck(t) },
+		ProviderFactories: acctest.AccTestProviders,
+		CheckDestroy:      testAccCheckNetworkingFloatingIPPortForwardingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.AccTestRenderConfig(testAccNetworkingFloatingIPPortForwardingBasic),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vkcs_networking_floatingip_port_forwarding.ssh", "external_port", "2222"),
+					resource.TestCheckResourceAttr("vkcs_networking_floatingip_port_forwarding.ssh", "internal_port", "22"),
+					resource.TestCheckResourceAttr("vkcs_networking_floatingip_port_forwarding.app", "external_port_range", "8000:8009"),
+					resource.TestCheckResourceAttr("vkcs_networking_floatingip_port_forwarding.app", "internal_port_range", "9000:9009"),
+				),
+			},
+			{
+				ResourceName:      "vkcs_networking_floatingip_port_forwarding.ssh",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:      acctest.AccTestRenderConfig(testAccNetworkingFloatingIPPortForwardingOverlap),
+				ExpectError: regexp.MustCompile("overlap"),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingFloatingIPPortForwardingDestroy(s *terraform.State) error {
+	config := acctest.AccTestProvider.Meta().(clients.Config)
+	networkingClient, err := config.NetworkingV2Client(acctest.OsRegionName, networking.DefaultSDN)
+	if err != nil {
+		return fmt.Errorf("Error creating VKCS networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vkcs_networking_floatingip_port_forwarding" {
+			continue
+		}
+
+		parts := strings.Split(rs.Primary.ID, "/")
+		if _, err := portforwarding.Get(networkingClient, parts[0], parts[1]).Extract(); err == nil {
+			return fmt.Errorf("Port forwarding still exists")
+		}
+	}
+
+	return nil
+}
+
+const testAccNetworkingFloatingIPPortForwardingBasic = `
+{{.BaseExtNetwork}}
+
+resource "vkcs_networking_network" "network_1" {
+  name = "network_1"
+  sdn  = "neutron"
+}
+
+resource "vkcs_networking_subnet" "subnet_1" {
+  name       = "subnet_1"
+  cidr       = "192.168.199.0/24"
+  network_id = vkcs_networking_network.network_1.id
+  sdn        = "neutron"
+}
+
+resource "vkcs_networking_router" "router_1" {
+  name                = "router_1"
+  external_network_id = data.vkcs_networking_network.extnet.id
+  sdn                 = "neutron"
+}
+
+resource "vkcs_networking_router_interface" "router_interface_1" {
+  router_id = vkcs_networking_router.router_1.id
+  subnet_id = vkcs_networking_subnet.subnet_1.id
+  sdn       = "neutron"
+}
+
+resource "vkcs_networking_port" "port_1" {
+  network_id = vkcs_networking_subnet.subnet_1.network_id
+  sdn        = "neutron"
+
+  fixed_ip {
+    subnet_id  = vkcs_networking_subnet.subnet_1.id
+    ip_address = "192.168.199.20"
+  }
+}
+
+resource "vkcs_networking_floatingip" "fip_1" {
+  pool = "{{.ExtNetName}}"
+  sdn  = "neutron"
+
+  depends_on = [vkcs_networking_router_interface.router_interface_1]
+}
+
+resource "vkcs_networking_floatingip_port_forwarding" "ssh" {
+  floatingip_id       = vkcs_networking_floatingip.fip_1.id
+  protocol            = "tcp"
+  external_port       = 2222
+  internal_port       = 22
+  internal_ip_address = "192.168.199.20"
+  internal_port_id    = vkcs_networking_port.port_1.id
+  sdn                 = "neutron"
+}
+
+resource "vkcs_networking_floatingip_port_forwarding" "app" {
+  floatingip_id       = vkcs_networking_floatingip.fip_1.id
+  protocol            = "tcp"
+  external_port_range = "8000:8009"
+  internal_port_range = "9000:9009"
+  internal_ip_address = "192.168.199.20"
+  internal_port_id    = vkcs_networking_port.port_1.id
+  sdn                 = "neutron"
+}
+`
+
+const testAccNetworkingFloatingIPPortForwardingOverlap = testAccNetworkingFloatingIPPortForwardingBasic + `
+resource "vkcs_networking_floatingip_port_forwarding" "overlap" {
+  floatingip_id       = vkcs_networking_floatingip.fip_1.id
+  protocol            = "tcp"
+  external_port       = 8005
+  internal_port       = 80
+  internal_ip_address = "192.168.199.20"
+  internal_port_id    = vkcs_networking_port.port_1.id
+  sdn                 = "neutron"
+}
+`
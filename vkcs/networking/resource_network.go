@@ -17,6 +17,7 @@ import (
 
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/attributestags"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/portsecurity"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/qos/policies"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/networks"
 	iattributestags "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/networking/v2/attributestags"
 )
@@ -30,6 +31,7 @@ func ResourceNetworkingNetwork() *schema.Resource {
 		ReadContext:   resourceNetworkingNetworkRead,
 		UpdateContext: resourceNetworkingNetworkUpdate,
 		DeleteContext: resourceNetworkingNetworkDelete,
+		CustomizeDiff: resourceNetworkingQoSPolicyIDCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -105,6 +107,13 @@ func ResourceNetworkingNetwork() *schema.Resource {
 				Description: "Private dns domain name",
 			},
 
+			"qos_policy_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Reference to the associated QoS policy. The policy is applied to ports of the network which have no QoS policy of their own. See `vkcs_networking_qos_policy`. Set this argument to an empty string to detach the policy from the network.",
+			},
+
 			"sdn": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -156,6 +165,13 @@ func resourceNetworkingNetworkCreate(ctx context.Context, d *schema.ResourceData
 		PortSecurityEnabled: &pse,
 	}
 
+	if qosPolicyID := d.Get("qos_policy_id").(string); qosPolicyID != "" {
+		finalCreateOpts = policies.NetworkCreateOptsExt{
+			CreateOptsBuilder: finalCreateOpts,
+			QoSPolicyID:       qosPolicyID,
+		}
+	}
+
 	log.Printf("[DEBUG] vkcs_networking_network create options: %#v", finalCreateOpts)
 	var n *networks.Network
 	err = errutil.Retry(func() error {
@@ -230,6 +246,7 @@ func resourceNetworkingNetworkRead(ctx context.Context, d *schema.ResourceData,
 	d.Set("port_security_enabled", network.PortSecurityEnabled)
 	d.Set("region", util.GetRegion(d, config))
 	d.Set("private_dns_domain", network.PrivateDNSDomain)
+	d.Set("qos_policy_id", network.QoSPolicyID)
 	d.Set("sdn", network.SDN)
 	d.Set("vkcs_services_access", network.ServicesAccess)
 
@@ -296,6 +313,15 @@ func resourceNetworkingNetworkUpdate(ctx context.Context, d *schema.ResourceData
 		}
 	}
 
+	// Populate QoS options.
+	if d.HasChange("qos_policy_id") {
+		qosPolicyID := d.Get("qos_policy_id").(string)
+		finalUpdateOpts = policies.NetworkUpdateOptsExt{
+			UpdateOptsBuilder: finalUpdateOpts,
+			QoSPolicyID:       &qosPolicyID,
+		}
+	}
+
 	log.Printf("[DEBUG] vkcs_networking_network %s update options: %#v", d.Id(), finalUpdateOpts)
 	_, err = inetworks.Update(networkingClient, d.Id(), finalUpdateOpts).Extract()
 	if err != nil {
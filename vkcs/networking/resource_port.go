@@ -2,10 +2,12 @@ package networking
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/clients"
@@ -16,6 +18,7 @@ import (
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/dns"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/extradhcpopts"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/portsecurity"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/qos/policies"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
 	iattributestags "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/networking/v2/attributestags"
 	iports "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/networking/v2/ports"
@@ -27,7 +30,11 @@ func ResourceNetworkingPort() *schema.Resource {
 		ReadContext:   resourceNetworkingPortRead,
 		UpdateContext: resourceNetworkingPortUpdate,
 		DeleteContext: resourceNetworkingPortDelete,
-		CustomizeDiff: resourceNetworkingPortCustomizeDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceNetworkingPortCustomizeDiff,
+			resourceNetworkingPortValidateSDN,
+			resourceNetworkingQoSPolicyIDCustomizeDiff,
+		),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -163,27 +170,7 @@ func ResourceNetworkingPort() *schema.Resource {
 				Description:   "(Conflicts with `fixed_ip`) Create a port with no fixed IP address. This will also remove any fixed IPs previously set on a port. `true` is the only valid value for this argument.",
 			},
 
-			"allowed_address_pairs": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				ForceNew: false,
-				Set:      resourceNetworkingPortAllowedAddressPairsHash,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"ip_address": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "The additional IP address.",
-						},
-						"mac_address": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "The additional MAC address.",
-						},
-					},
-				},
-				Description: "An IP/MAC Address pair of additional IP addresses that can be active on this port. The structure is described below.",
-			},
+			"allowed_address_pairs": NetworkingPortAllowedAddressPairsSchema("An IP/MAC Address pair of additional IP addresses that can be active on this port. The structure is described below."),
 
 			"extra_dhcp_option": {
 				Type:     schema.TypeSet,
@@ -264,6 +251,13 @@ func ResourceNetworkingPort() *schema.Resource {
 				Description: "The list of maps representing port DNS assignments.",
 			},
 
+			"qos_policy_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Reference to the associated QoS policy. See `vkcs_networking_qos_policy`. Set this argument to an empty string to detach the policy from the port.",
+			},
+
 			"sdn": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -302,7 +296,7 @@ func resourceNetworkingPortCreate(ctx context.Context, d *schema.ResourceData, m
 			DeviceOwner:         d.Get("device_owner").(string),
 			DeviceID:            d.Get("device_id").(string),
 			FixedIPs:            expandNetworkingPortFixedIP(d),
-			AllowedAddressPairs: expandNetworkingPortAllowedAddressPairs(allowedAddressPairs),
+			AllowedAddressPairs: ExpandNetworkingPortAllowedAddressPairs(allowedAddressPairs),
 		},
 		ValueSpecs: util.MapValueSpecs(d),
 	}
@@ -348,6 +342,13 @@ func resourceNetworkingPortCreate(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 
+	if qosPolicyID := d.Get("qos_policy_id").(string); qosPolicyID != "" {
+		finalCreateOpts = policies.PortCreateOptsExt{
+			CreateOptsBuilder: finalCreateOpts,
+			QoSPolicyID:       qosPolicyID,
+		}
+	}
+
 	log.Printf("[DEBUG] vkcs_networking_port create options: %#v", finalCreateOpts)
 
 	// Create a Neutron port and set extra options if they're specified.
@@ -427,11 +428,12 @@ func resourceNetworkingPortRead(ctx context.Context, d *schema.ResourceData, met
 		d.Set("security_group_ids", port.SecurityGroups)
 	}
 
-	d.Set("allowed_address_pairs", flattenNetworkingPortAllowedAddressPairs(port.MACAddress, port.AllowedAddressPairs))
+	d.Set("allowed_address_pairs", FlattenNetworkingPortAllowedAddressPairs(port.MACAddress, port.AllowedAddressPairs))
 	d.Set("extra_dhcp_option", flattenNetworkingPortDHCPOpts(port.ExtraDHCPOptsExt))
 	d.Set("port_security_enabled", port.PortSecurityEnabled)
 	d.Set("dns_name", port.DNSName)
 	d.Set("dns_assignment", port.DNSAssignment)
+	d.Set("qos_policy_id", port.QoSPolicyID)
 
 	d.Set("region", util.GetRegion(d, config))
 	d.Set("sdn", port.SDN)
@@ -467,7 +469,7 @@ func resourceNetworkingPortUpdate(ctx context.Context, d *schema.ResourceData, m
 	if d.HasChange("allowed_address_pairs") {
 		hasChange = true
 		allowedAddressPairs := d.Get("allowed_address_pairs").(*schema.Set)
-		aap := expandNetworkingPortAllowedAddressPairs(allowedAddressPairs)
+		aap := ExpandNetworkingPortAllowedAddressPairs(allowedAddressPairs)
 		updateOpts.AllowedAddressPairs = &aap
 	}
 
@@ -562,6 +564,16 @@ func resourceNetworkingPortUpdate(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 
+	if d.HasChange("qos_policy_id") {
+		hasChange = true
+
+		qosPolicyID := d.Get("qos_policy_id").(string)
+		finalUpdateOpts = policies.PortUpdateOptsExt{
+			UpdateOptsBuilder: finalUpdateOpts,
+			QoSPolicyID:       &qosPolicyID,
+		}
+	}
+
 	// At this point, perform the update for all "standard" port changes.
 	if hasChange {
 		log.Printf("[DEBUG] vkcs_networking_port %s update options: %#v", d.Id(), finalUpdateOpts)
@@ -633,6 +645,14 @@ func resourceNetworkingPortCustomizeDiff(_ context.Context, diff *schema.Resourc
 	return nil
 }
 
+func resourceNetworkingPortValidateSDN(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	objects := []SDNObject{{Key: "network_id", Type: SDNObjectNetwork}}
+	for i := range diff.Get("fixed_ip").([]interface{}) {
+		objects = append(objects, SDNObject{Key: fmt.Sprintf("fixed_ip.%d.subnet_id", i), Type: SDNObjectSubnet})
+	}
+	return ValidateSDNConsistency(diff, meta, objects)
+}
+
 func needSGControl(d *schema.ResourceData) bool {
 	return d.Get("full_security_groups_control").(bool) && !d.GetRawConfig().GetAttr("security_group_ids").IsNull()
 }
@@ -0,0 +1,203 @@
+package networking
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/clients"
+	inetworking "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/networking"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/util"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/qos/rules"
+	ipolicies "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/networking/v2/qos/policies"
+	irules "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/networking/v2/qos/rules"
+)
+
+func ResourceNetworkingQoSBandwidthLimitRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetworkingQoSBandwidthLimitRuleCreate,
+		ReadContext:   resourceNetworkingQoSBandwidthLimitRuleRead,
+		UpdateContext: resourceNetworkingQoSBandwidthLimitRuleUpdate,
+		DeleteContext: resourceNetworkingQoSBandwidthLimitRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The region in which to obtain the networking client. A networking client is needed to create a QoS bandwidth limit rule. If omitted, the `region` argument of the provider is used. Changing this creates a new QoS bandwidth limit rule.",
+			},
+
+			"qos_policy_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The QoS policy reference. Changing this creates a new QoS bandwidth limit rule.",
+			},
+
+			"max_kbps": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The maximum kilobits per second of a QoS bandwidth limit rule. Changing this updates the maximum kilobits per second of the existing QoS bandwidth limit rule.",
+			},
+
+			"max_burst_kbps": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The maximum burst size in kilobits of a QoS bandwidth limit rule. Changing this updates the maximum burst size in kilobits of the existing QoS bandwidth limit rule.",
+			},
+
+			"direction": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "egress",
+				ValidateFunc: validation.StringInSlice([]string{"egress", "ingress"}, false),
+				Description:  "The direction of traffic. Must be one of \"egress\", \"ingress\". Defaults to \"egress\". Changing this updates the direction of the existing QoS bandwidth limit rule.",
+			},
+
+			"sdn": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Computed:         true,
+				ValidateDiagFunc: ValidateSDN(),
+				Description:      "SDN to use for this resource. Must be one of following: \"neutron\", \"sprut\". Default value is project's default SDN.",
+			},
+		},
+		Description: "Manages a QoS bandwidth limit rule resource within VKCS.",
+	}
+}
+
+func resourceNetworkingQoSBandwidthLimitRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(clients.Config)
+	networkingClient, err := config.NetworkingV2Client(util.GetRegion(d, config), GetSDN(d))
+	if err != nil {
+		return diag.Errorf("Error creating VKCS networking client: %s", err)
+	}
+
+	createOpts := rules.CreateBandwidthLimitRuleOpts{
+		MaxKBps:      d.Get("max_kbps").(int),
+		MaxBurstKBps: d.Get("max_burst_kbps").(int),
+		Direction:    d.Get("direction").(string),
+	}
+	qosPolicyID := d.Get("qos_policy_id").(string)
+
+	log.Printf("[DEBUG] vkcs_networking_qos_bandwidth_limit_rule create options: %#v", createOpts)
+
+	r, err := irules.CreateBandwidthLimitRule(networkingClient, qosPolicyID, createOpts).ExtractBandwidthLimitRule()
+	if err != nil {
+		return diag.Errorf("Error creating vkcs_networking_qos_bandwidth_limit_rule: %s", err)
+	}
+
+	d.SetId(resourceNetworkingQoSRuleBuildID(qosPolicyID, r.ID))
+
+	log.Printf("[DEBUG] Created vkcs_networking_qos_bandwidth_limit_rule %s: %#v", d.Id(), r)
+	return resourceNetworkingQoSBandwidthLimitRuleRead(ctx, d, meta)
+}
+
+func resourceNetworkingQoSBandwidthLimitRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(clients.Config)
+	networkingClient, err := config.NetworkingV2Client(util.GetRegion(d, config), inetworking.SearchInAllSDNs)
+	if err != nil {
+		return diag.Errorf("Error creating VKCS networking client: %s", err)
+	}
+
+	qosPolicyID, qosRuleID, err := resourceNetworkingQoSRuleParseID(d.Id())
+	if err != nil {
+		return diag.Errorf("Error reading vkcs_networking_qos_bandwidth_limit_rule ID %s: %s", d.Id(), err)
+	}
+
+	r, err := irules.GetBandwidthLimitRule(networkingClient, qosPolicyID, qosRuleID).ExtractBandwidthLimitRule()
+	if err != nil {
+		return diag.FromErr(util.CheckDeleted(d, err, "Error getting vkcs_networking_qos_bandwidth_limit_rule"))
+	}
+
+	log.Printf("[DEBUG] Retrieved vkcs_networking_qos_bandwidth_limit_rule %s: %#v", d.Id(), r)
+
+	var p qosPolicyExtended
+	err = ipolicies.ExtractPolicyInto(ipolicies.Get(networkingClient, qosPolicyID), &p)
+	if err != nil {
+		return diag.FromErr(util.CheckDeleted(d, err, "Error getting vkcs_networking_qos_policy"))
+	}
+
+	d.Set("qos_policy_id", qosPolicyID)
+	d.Set("max_kbps", r.MaxKBps)
+	d.Set("max_burst_kbps", r.MaxBurstKBps)
+	d.Set("direction", r.Direction)
+	d.Set("region", util.GetRegion(d, config))
+	d.Set("sdn", p.SDN)
+
+	return nil
+}
+
+func resourceNetworkingQoSBandwidthLimitRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(clients.Config)
+	networkingClient, err := config.NetworkingV2Client(util.GetRegion(d, config), inetworking.SearchInAllSDNs)
+	if err != nil {
+		return diag.Errorf("Error creating VKCS networking client: %s", err)
+	}
+
+	qosPolicyID, qosRuleID, err := resourceNetworkingQoSRuleParseID(d.Id())
+	if err != nil {
+		return diag.Errorf("Error reading vkcs_networking_qos_bandwidth_limit_rule ID %s: %s", d.Id(), err)
+	}
+
+	var hasChange bool
+	var updateOpts rules.UpdateBandwidthLimitRuleOpts
+
+	if d.HasChange("max_kbps") {
+		hasChange = true
+		maxKBps := d.Get("max_kbps").(int)
+		updateOpts.MaxKBps = &maxKBps
+	}
+
+	if d.HasChange("max_burst_kbps") {
+		hasChange = true
+		maxBurstKBps := d.Get("max_burst_kbps").(int)
+		updateOpts.MaxBurstKBps = &maxBurstKBps
+	}
+
+	if d.HasChange("direction") {
+		hasChange = true
+		updateOpts.Direction = d.Get("direction").(string)
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] vkcs_networking_qos_bandwidth_limit_rule %s update options: %#v", d.Id(), updateOpts)
+		_, err = irules.UpdateBandwidthLimitRule(networkingClient, qosPolicyID, qosRuleID, updateOpts).ExtractBandwidthLimitRule()
+		if err != nil {
+			return diag.Errorf("Error updating vkcs_networking_qos_bandwidth_limit_rule %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceNetworkingQoSBandwidthLimitRuleRead(ctx, d, meta)
+}
+
+func resourceNetworkingQoSBandwidthLimitRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(clients.Config)
+	networkingClient, err := config.NetworkingV2Client(util.GetRegion(d, config), inetworking.SearchInAllSDNs)
+	if err != nil {
+		return diag.Errorf("Error creating VKCS networking client: %s", err)
+	}
+
+	qosPolicyID, qosRuleID, err := resourceNetworkingQoSRuleParseID(d.Id())
+	if err != nil {
+		return diag.Errorf("Error reading vkcs_networking_qos_bandwidth_limit_rule ID %s: %s", d.Id(), err)
+	}
+
+	if err := irules.DeleteBandwidthLimitRule(networkingClient, qosPolicyID, qosRuleID).ExtractErr(); err != nil {
+		return diag.FromErr(util.CheckDeleted(d, err, "Error deleting vkcs_networking_qos_bandwidth_limit_rule"))
+	}
+
+	return nil
+}
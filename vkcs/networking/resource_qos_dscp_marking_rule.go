@@ -0,0 +1,172 @@
+package networking
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/clients"
+	inetworking "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/networking"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/util"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/qos/rules"
+	ipolicies "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/networking/v2/qos/policies"
+	irules "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/networking/v2/qos/rules"
+)
+
+func ResourceNetworkingQoSDSCPMarkingRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetworkingQoSDSCPMarkingRuleCreate,
+		ReadContext:   resourceNetworkingQoSDSCPMarkingRuleRead,
+		UpdateContext: resourceNetworkingQoSDSCPMarkingRuleUpdate,
+		DeleteContext: resourceNetworkingQoSDSCPMarkingRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The region in which to obtain the networking client. A networking client is needed to create a QoS DSCP marking rule. If omitted, the `region` argument of the provider is used. Changing this creates a new QoS DSCP marking rule.",
+			},
+
+			"qos_policy_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The QoS policy reference. Changing this creates a new QoS DSCP marking rule.",
+			},
+
+			"dscp_mark": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntInSlice(qosDSCPMarks),
+				Description:  "The value of DSCP mark. Must be one of 0, 8, 10, 12, 14, 16, 18, 20, 22, 24, 26, 28, 30, 32, 34, 36, 38, 40, 46, 48, 56. Changing this updates the DSCP mark value of the existing QoS DSCP marking rule.",
+			},
+
+			"sdn": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Computed:         true,
+				ValidateDiagFunc: ValidateSDN(),
+				Description:      "SDN to use for this resource. Must be one of following: \"neutron\", \"sprut\". Default value is project's default SDN.",
+			},
+		},
+		Description: "Manages a QoS DSCP marking rule resource within VKCS.",
+	}
+}
+
+func resourceNetworkingQoSDSCPMarkingRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(clients.Config)
+	networkingClient, err := config.NetworkingV2Client(util.GetRegion(d, config), GetSDN(d))
+	if err != nil {
+		return diag.Errorf("Error creating VKCS networking client: %s", err)
+	}
+
+	createOpts := rules.CreateDSCPMarkingRuleOpts{
+		DSCPMark: d.Get("dscp_mark").(int),
+	}
+	qosPolicyID := d.Get("qos_policy_id").(string)
+
+	log.Printf("[DEBUG] vkcs_networking_qos_dscp_marking_rule create options: %#v", createOpts)
+
+	r, err := irules.CreateDSCPMarkingRule(networkingClient, qosPolicyID, createOpts).ExtractDSCPMarkingRule()
+	if err != nil {
+		return diag.Errorf("Error creating vkcs_networking_qos_dscp_marking_rule: %s", err)
+	}
+
+	d.SetId(resourceNetworkingQoSRuleBuildID(qosPolicyID, r.ID))
+
+	log.Printf("[DEBUG] Created vkcs_networking_qos_dscp_marking_rule %s: %#v", d.Id(), r)
+	return resourceNetworkingQoSDSCPMarkingRuleRead(ctx, d, meta)
+}
+
+func resourceNetworkingQoSDSCPMarkingRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(clients.Config)
+	networkingClient, err := config.NetworkingV2Client(util.GetRegion(d, config), inetworking.SearchInAllSDNs)
+	if err != nil {
+		return diag.Errorf("Error creating VKCS networking client: %s", err)
+	}
+
+	qosPolicyID, qosRuleID, err := resourceNetworkingQoSRuleParseID(d.Id())
+	if err != nil {
+		return diag.Errorf("Error reading vkcs_networking_qos_dscp_marking_rule ID %s: %s", d.Id(), err)
+	}
+
+	r, err := irules.GetDSCPMarkingRule(networkingClient, qosPolicyID, qosRuleID).ExtractDSCPMarkingRule()
+	if err != nil {
+		return diag.FromErr(util.CheckDeleted(d, err, "Error getting vkcs_networking_qos_dscp_marking_rule"))
+	}
+
+	log.Printf("[DEBUG] Retrieved vkcs_networking_qos_dscp_marking_rule %s: %#v", d.Id(), r)
+
+	var p qosPolicyExtended
+	err = ipolicies.ExtractPolicyInto(ipolicies.Get(networkingClient, qosPolicyID), &p)
+	if err != nil {
+		return diag.FromErr(util.CheckDeleted(d, err, "Error getting vkcs_networking_qos_policy"))
+	}
+
+	d.Set("qos_policy_id", qosPolicyID)
+	d.Set("dscp_mark", r.DSCPMark)
+	d.Set("region", util.GetRegion(d, config))
+	d.Set("sdn", p.SDN)
+
+	return nil
+}
+
+func resourceNetworkingQoSDSCPMarkingRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(clients.Config)
+	networkingClient, err := config.NetworkingV2Client(util.GetRegion(d, config), inetworking.SearchInAllSDNs)
+	if err != nil {
+		return diag.Errorf("Error creating VKCS networking client: %s", err)
+	}
+
+	qosPolicyID, qosRuleID, err := resourceNetworkingQoSRuleParseID(d.Id())
+	if err != nil {
+		return diag.Errorf("Error reading vkcs_networking_qos_dscp_marking_rule ID %s: %s", d.Id(), err)
+	}
+
+	var hasChange bool
+	var updateOpts rules.UpdateDSCPMarkingRuleOpts
+
+	if d.HasChange("dscp_mark") {
+		hasChange = true
+		dscpMark := d.Get("dscp_mark").(int)
+		updateOpts.DSCPMark = &dscpMark
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] vkcs_networking_qos_dscp_marking_rule %s update options: %#v", d.Id(), updateOpts)
+		_, err = irules.UpdateDSCPMarkingRule(networkingClient, qosPolicyID, qosRuleID, updateOpts).ExtractDSCPMarkingRule()
+		if err != nil {
+			return diag.Errorf("Error updating vkcs_networking_qos_dscp_marking_rule %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceNetworkingQoSDSCPMarkingRuleRead(ctx, d, meta)
+}
+
+func resourceNetworkingQoSDSCPMarkingRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(clients.Config)
+	networkingClient, err := config.NetworkingV2Client(util.GetRegion(d, config), inetworking.SearchInAllSDNs)
+	if err != nil {
+		return diag.Errorf("Error creating VKCS networking client: %s", err)
+	}
+
+	qosPolicyID, qosRuleID, err := resourceNetworkingQoSRuleParseID(d.Id())
+	if err != nil {
+		return diag.Errorf("Error reading vkcs_networking_qos_dscp_marking_rule ID %s: %s", d.Id(), err)
+	}
+
+	if err := irules.DeleteDSCPMarkingRule(networkingClient, qosPolicyID, qosRuleID).ExtractErr(); err != nil {
+		return diag.FromErr(util.CheckDeleted(d, err, "Error deleting vkcs_networking_qos_dscp_marking_rule"))
+	}
+
+	return nil
+}
@@ -0,0 +1,236 @@
+package networking
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/clients"
+	inetworking "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/networking"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/util"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/attributestags"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/qos/policies"
+	iattributestags "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/networking/v2/attributestags"
+	ipolicies "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/networking/v2/qos/policies"
+)
+
+func ResourceNetworkingQoSPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetworkingQoSPolicyCreate,
+		ReadContext:   resourceNetworkingQoSPolicyRead,
+		UpdateContext: resourceNetworkingQoSPolicyUpdate,
+		DeleteContext: resourceNetworkingQoSPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The region in which to obtain the networking client. A networking client is needed to create a QoS policy. If omitted, the `region` argument of the provider is used. Changing this creates a new QoS policy.",
+			},
+
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the QoS policy. Changing this updates the name of the existing QoS policy.",
+			},
+
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Human-readable description of the QoS policy. Changing this updates the description of the existing QoS policy.",
+			},
+
+			"shared": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Indicates whether this QoS policy is shared across all projects. Changing this updates the shared status of the existing QoS policy.",
+			},
+
+			"is_default": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Indicates whether the QoS policy is default QoS policy of the project. Changing this updates the default status of the existing QoS policy.",
+			},
+
+			"tags": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "A set of string tags for the QoS policy.",
+			},
+
+			"all_tags": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The collection of tags assigned on the QoS policy, which have been explicitly and implicitly added.",
+			},
+
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time at which the QoS policy was created.",
+			},
+
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time at which the QoS policy was last updated.",
+			},
+
+			"sdn": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Computed:         true,
+				ValidateDiagFunc: ValidateSDN(),
+				Description:      "SDN to use for this resource. Must be one of following: \"neutron\", \"sprut\". Default value is project's default SDN.",
+			},
+		},
+		Description: "Manages a QoS policy resource within VKCS. QoS policy rules are managed with `vkcs_networking_qos_bandwidth_limit_rule` and `vkcs_networking_qos_dscp_marking_rule`.",
+	}
+}
+
+func resourceNetworkingQoSPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(clients.Config)
+	networkingClient, err := config.NetworkingV2Client(util.GetRegion(d, config), GetSDN(d))
+	if err != nil {
+		return diag.Errorf("Error creating VKCS networking client: %s", err)
+	}
+
+	createOpts := policies.CreateOpts{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Shared:      d.Get("shared").(bool),
+		IsDefault:   d.Get("is_default").(bool),
+	}
+
+	log.Printf("[DEBUG] vkcs_networking_qos_policy create options: %#v", createOpts)
+
+	p, err := ipolicies.Create(networkingClient, createOpts).Extract()
+	if err != nil {
+		return diag.Errorf("Error creating vkcs_networking_qos_policy: %s", err)
+	}
+
+	d.SetId(p.ID)
+
+	tags := NetworkingAttributesTags(d)
+	if len(tags) > 0 {
+		tagOpts := attributestags.ReplaceAllOpts{Tags: tags}
+		tags, err := iattributestags.ReplaceAll(networkingClient, "policies", p.ID, tagOpts).Extract()
+		if err != nil {
+			return diag.Errorf("Error setting tags on vkcs_networking_qos_policy %s: %s", p.ID, err)
+		}
+		log.Printf("[DEBUG] Set tags %s on vkcs_networking_qos_policy %s", tags, p.ID)
+	}
+
+	log.Printf("[DEBUG] Created vkcs_networking_qos_policy %s: %#v", p.ID, p)
+	return resourceNetworkingQoSPolicyRead(ctx, d, meta)
+}
+
+func resourceNetworkingQoSPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(clients.Config)
+	networkingClient, err := config.NetworkingV2Client(util.GetRegion(d, config), inetworking.SearchInAllSDNs)
+	if err != nil {
+		return diag.Errorf("Error creating VKCS networking client: %s", err)
+	}
+
+	var p qosPolicyExtended
+	err = ipolicies.ExtractPolicyInto(ipolicies.Get(networkingClient, d.Id()), &p)
+	if err != nil {
+		return diag.FromErr(util.CheckDeleted(d, err, "Error getting vkcs_networking_qos_policy"))
+	}
+
+	log.Printf("[DEBUG] Retrieved vkcs_networking_qos_policy %s: %#v", d.Id(), p)
+
+	d.Set("name", p.Name)
+	d.Set("description", p.Description)
+	d.Set("shared", p.Shared)
+	d.Set("is_default", p.IsDefault)
+	d.Set("created_at", p.CreatedAt.Format(time.RFC3339))
+	d.Set("updated_at", p.UpdatedAt.Format(time.RFC3339))
+	d.Set("region", util.GetRegion(d, config))
+	d.Set("sdn", p.SDN)
+
+	NetworkingReadAttributesTags(d, p.Tags)
+
+	return nil
+}
+
+func resourceNetworkingQoSPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(clients.Config)
+	networkingClient, err := config.NetworkingV2Client(util.GetRegion(d, config), inetworking.SearchInAllSDNs)
+	if err != nil {
+		return diag.Errorf("Error creating VKCS networking client: %s", err)
+	}
+
+	var hasChange bool
+	var updateOpts policies.UpdateOpts
+
+	if d.HasChange("name") {
+		hasChange = true
+		updateOpts.Name = d.Get("name").(string)
+	}
+
+	if d.HasChange("description") {
+		hasChange = true
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+
+	if d.HasChange("shared") {
+		hasChange = true
+		shared := d.Get("shared").(bool)
+		updateOpts.Shared = &shared
+	}
+
+	if d.HasChange("is_default") {
+		hasChange = true
+		isDefault := d.Get("is_default").(bool)
+		updateOpts.IsDefault = &isDefault
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] vkcs_networking_qos_policy %s update options: %#v", d.Id(), updateOpts)
+		_, err = ipolicies.Update(networkingClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return diag.Errorf("Error updating vkcs_networking_qos_policy %s: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		tags := NetworkingV2UpdateAttributesTags(d)
+		tagOpts := attributestags.ReplaceAllOpts{Tags: tags}
+		tags, err := iattributestags.ReplaceAll(networkingClient, "policies", d.Id(), tagOpts).Extract()
+		if err != nil {
+			return diag.Errorf("Error setting tags on vkcs_networking_qos_policy %s: %s", d.Id(), err)
+		}
+		log.Printf("[DEBUG] Set tags %s on vkcs_networking_qos_policy %s", tags, d.Id())
+	}
+
+	return resourceNetworkingQoSPolicyRead(ctx, d, meta)
+}
+
+func resourceNetworkingQoSPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(clients.Config)
+	networkingClient, err := config.NetworkingV2Client(util.GetRegion(d, config), inetworking.SearchInAllSDNs)
+	if err != nil {
+		return diag.Errorf("Error creating VKCS networking client: %s", err)
+	}
+
+	if err := ipolicies.Delete(networkingClient, d.Id()).ExtractErr(); err != nil {
+		return diag.FromErr(util.CheckDeleted(d, err, "Error deleting vkcs_networking_qos_policy"))
+	}
+
+	return nil
+}
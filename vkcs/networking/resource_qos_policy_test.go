@@ -0,0 +1,185 @@
+package networking_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/qos/policies"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/qos/rules"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/acctest"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/clients"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/networking"
+)
+
+func TestAccNetworkingQoSPolicy_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.AccTestPreCheck(t) },
+		ProviderFactories: acctest.AccTestProviders,
+		CheckDestroy:      testAccCheckNetworkingQoSPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingQoSPolicyBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingQoSPolicyExists("vkcs_networking_qos_policy.policy_1"),
+					resource.TestCheckResourceAttr("vkcs_networking_qos_policy.policy_1", "name", "policy_1"),
+					resource.TestCheckResourceAttr("vkcs_networking_qos_bandwidth_limit_rule.bw_limit_rule_1", "max_kbps", "3000"),
+					resource.TestCheckResourceAttr("vkcs_networking_qos_bandwidth_limit_rule.bw_limit_rule_1", "max_burst_kbps", "300"),
+					resource.TestCheckResourceAttr("vkcs_networking_qos_bandwidth_limit_rule.bw_limit_rule_1", "direction", "egress"),
+					resource.TestCheckResourceAttr("vkcs_networking_qos_dscp_marking_rule.dscp_marking_rule_1", "dscp_mark", "26"),
+					resource.TestCheckResourceAttrPair("vkcs_networking_port.port_1", "qos_policy_id", "vkcs_networking_qos_policy.policy_1", "id"),
+					resource.TestCheckResourceAttrPair("vkcs_networking_network.network_1", "qos_policy_id", "vkcs_networking_qos_policy.policy_1", "id"),
+				),
+			},
+			{
+				Config: testAccNetworkingQoSPolicyUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vkcs_networking_qos_policy.policy_1", "name", "policy_1_updated"),
+					resource.TestCheckResourceAttr("vkcs_networking_qos_bandwidth_limit_rule.bw_limit_rule_1", "max_kbps", "5000"),
+					resource.TestCheckResourceAttr("vkcs_networking_qos_dscp_marking_rule.dscp_marking_rule_1", "dscp_mark", "34"),
+					resource.TestCheckResourceAttr("vkcs_networking_port.port_1", "qos_policy_id", ""),
+					resource.TestCheckResourceAttr("vkcs_networking_network.network_1", "qos_policy_id", ""),
+				),
+			},
+			{
+				ResourceName:      "vkcs_networking_qos_bandwidth_limit_rule.bw_limit_rule_1",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "vkcs_networking_qos_dscp_marking_rule.dscp_marking_rule_1",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingQoSPolicyExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := acctest.AccTestProvider.Meta().(clients.Config)
+		networkingClient, err := config.NetworkingV2Client(acctest.OsRegionName, networking.DefaultSDN)
+		if err != nil {
+			return fmt.Errorf("Error creating VKCS networking client: %s", err)
+		}
+
+		found, err := policies.Get(networkingClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("QoS policy not found")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckNetworkingQoSPolicyDestroy(s *terraform.State) error {
+	config := acctest.AccTestProvider.Meta().(clients.Config)
+	networkingClient, err := config.NetworkingV2Client(acctest.OsRegionName, networking.DefaultSDN)
+	if err != nil {
+		return fmt.Errorf("Error creating VKCS networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		switch rs.Type {
+		case "vkcs_networking_qos_policy":
+			if _, err := policies.Get(networkingClient, rs.Primary.ID).Extract(); err == nil {
+				return fmt.Errorf("QoS policy still exists")
+			}
+		case "vkcs_networking_qos_bandwidth_limit_rule":
+			parts := strings.Split(rs.Primary.ID, "/")
+			if _, err := rules.GetBandwidthLimitRule(networkingClient, parts[0], parts[1]).ExtractBandwidthLimitRule(); err == nil {
+				return fmt.Errorf("QoS bandwidth limit rule still exists")
+			}
+		case "vkcs_networking_qos_dscp_marking_rule":
+			parts := strings.Split(rs.Primary.ID, "/")
+			if _, err := rules.GetDSCPMarkingRule(networkingClient, parts[0], parts[1]).ExtractDSCPMarkingRule(); err == nil {
+				return fmt.Errorf("QoS DSCP marking rule still exists")
+			}
+		}
+	}
+
+	return nil
+}
+
+const testAccNetworkingQoSPolicyBasic = `
+resource "vkcs_networking_qos_policy" "policy_1" {
+  name        = "policy_1"
+  description = "terraform acceptance test"
+  sdn         = "neutron"
+}
+
+resource "vkcs_networking_qos_bandwidth_limit_rule" "bw_limit_rule_1" {
+  qos_policy_id  = vkcs_networking_qos_policy.policy_1.id
+  max_kbps       = 3000
+  max_burst_kbps = 300
+  sdn            = "neutron"
+}
+
+resource "vkcs_networking_qos_dscp_marking_rule" "dscp_marking_rule_1" {
+  qos_policy_id = vkcs_networking_qos_policy.policy_1.id
+  dscp_mark     = 26
+  sdn           = "neutron"
+}
+
+resource "vkcs_networking_network" "network_1" {
+  name          = "network_1"
+  qos_policy_id = vkcs_networking_qos_policy.policy_1.id
+  sdn           = "neutron"
+}
+
+resource "vkcs_networking_port" "port_1" {
+  name          = "port_1"
+  network_id    = vkcs_networking_network.network_1.id
+  qos_policy_id = vkcs_networking_qos_policy.policy_1.id
+  sdn           = "neutron"
+}
+`
+
+const testAccNetworkingQoSPolicyUpdate = `
+resource "vkcs_networking_qos_policy" "policy_1" {
+  name        = "policy_1_updated"
+  description = "terraform acceptance test"
+  sdn         = "neutron"
+}
+
+resource "vkcs_networking_qos_bandwidth_limit_rule" "bw_limit_rule_1" {
+  qos_policy_id  = vkcs_networking_qos_policy.policy_1.id
+  max_kbps       = 5000
+  max_burst_kbps = 300
+  sdn            = "neutron"
+}
+
+resource "vkcs_networking_qos_dscp_marking_rule" "dscp_marking_rule_1" {
+  qos_policy_id = vkcs_networking_qos_policy.policy_1.id
+  dscp_mark     = 34
+  sdn           = "neutron"
+}
+
+resource "vkcs_networking_network" "network_1" {
+  name          = "network_1"
+  qos_policy_id = ""
+  sdn           = "neutron"
+}
+
+resource "vkcs_networking_port" "port_1" {
+  name          = "port_1"
+  network_id    = vkcs_networking_network.network_1.id
+  qos_policy_id = ""
+  sdn           = "neutron"
+}
+`
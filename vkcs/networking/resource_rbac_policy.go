@@ -0,0 +1,178 @@
+package networking
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/clients"
+	inetworking "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/networking"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/util"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/rbacpolicies"
+	irbacpolicies "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/networking/v2/rbacpolicies"
+)
+
+func ResourceNetworkingRBACPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetworkingRBACPolicyCreate,
+		ReadContext:   resourceNetworkingRBACPolicyRead,
+		UpdateContext: resourceNetworkingRBACPolicyUpdate,
+		DeleteContext: resourceNetworkingRBACPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: resourceNetworkingRBACPolicyCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The region in which to obtain the networking client. A networking client is needed to create a RBAC policy. If omitted, the `region` argument of the provider is used. Changing this creates a new RBAC policy.",
+			},
+
+			"object_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(rbacPolicyObjectTypes, false),
+				Description:  "The type of the object that the RBAC policy affects. Must be one of \"network\", \"qos_policy\", \"security_group\". Changing this creates a new RBAC policy.",
+			},
+
+			"object_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the object that the RBAC policy affects. Changing this creates a new RBAC policy.",
+			},
+
+			"action": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(rbacPolicyActions, false),
+				Description:  "Action for the RBAC policy. Must be one of \"access_as_shared\", \"access_as_external\". \"access_as_external\" is only supported for networks. Changing this creates a new RBAC policy.",
+			},
+
+			"target_project_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the project to which the RBAC policy will be enforced. Use \"*\" to grant access to all projects. Changing this updates the target project of the existing RBAC policy.",
+			},
+
+			"project_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the project the RBAC policy belongs to.",
+			},
+
+			"sdn": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Computed:         true,
+				ValidateDiagFunc: ValidateSDN(),
+				Description:      "SDN to use for this resource. Must be one of following: \"neutron\", \"sprut\". Default value is project's default SDN. Must match the SDN of the object.",
+			},
+		},
+		Description: "Manages a RBAC policy resource within VKCS. RBAC policies allow to share networks, QoS policies and security groups with other projects.",
+	}
+}
+
+func resourceNetworkingRBACPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(clients.Config)
+	networkingClient, err := config.NetworkingV2Client(util.GetRegion(d, config), GetSDN(d))
+	if err != nil {
+		return diag.Errorf("Error creating VKCS networking client: %s", err)
+	}
+
+	createOpts := rbacpolicies.CreateOpts{
+		Action:       rbacpolicies.PolicyAction(d.Get("action").(string)),
+		ObjectType:   d.Get("object_type").(string),
+		ObjectID:     d.Get("object_id").(string),
+		TargetTenant: d.Get("target_project_id").(string),
+	}
+
+	log.Printf("[DEBUG] vkcs_networking_rbac_policy create options: %#v", createOpts)
+
+	p, err := irbacpolicies.Create(networkingClient, createOpts).Extract()
+	if err != nil {
+		return diag.Errorf("Error creating vkcs_networking_rbac_policy: %s", err)
+	}
+
+	d.SetId(p.ID)
+
+	log.Printf("[DEBUG] Created vkcs_networking_rbac_policy %s: %#v", p.ID, p)
+	return resourceNetworkingRBACPolicyRead(ctx, d, meta)
+}
+
+func resourceNetworkingRBACPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(clients.Config)
+	networkingClient, err := config.NetworkingV2Client(util.GetRegion(d, config), inetworking.SearchInAllSDNs)
+	if err != nil {
+		return diag.Errorf("Error creating VKCS networking client: %s", err)
+	}
+
+	var p rbacPolicyExtended
+	err = irbacpolicies.ExtractRBACPolicyInto(irbacpolicies.Get(networkingClient, d.Id()), &p)
+	if err != nil {
+		return diag.FromErr(util.CheckDeleted(d, err, "Error getting vkcs_networking_rbac_policy"))
+	}
+
+	log.Printf("[DEBUG] Retrieved vkcs_networking_rbac_policy %s: %#v", d.Id(), p)
+
+	d.Set("object_type", p.ObjectType)
+	d.Set("object_id", p.ObjectID)
+	d.Set("action", string(p.Action))
+	d.Set("target_project_id", p.TargetTenant)
+	d.Set("project_id", p.ProjectID)
+	d.Set("region", util.GetRegion(d, config))
+	d.Set("sdn", p.SDN)
+
+	return nil
+}
+
+func resourceNetworkingRBACPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(clients.Config)
+	networkingClient, err := config.NetworkingV2Client(util.GetRegion(d, config), inetworking.SearchInAllSDNs)
+	if err != nil {
+		return diag.Errorf("Error creating VKCS networking client: %s", err)
+	}
+
+	if d.HasChange("target_project_id") {
+		updateOpts := rbacpolicies.UpdateOpts{
+			TargetTenant: d.Get("target_project_id").(string),
+		}
+
+		log.Printf("[DEBUG] vkcs_networking_rbac_policy %s update options: %#v", d.Id(), updateOpts)
+		_, err = irbacpolicies.Update(networkingClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return diag.Errorf("Error updating vkcs_networking_rbac_policy %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceNetworkingRBACPolicyRead(ctx, d, meta)
+}
+
+func resourceNetworkingRBACPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(clients.Config)
+	networkingClient, err := config.NetworkingV2Client(util.GetRegion(d, config), inetworking.SearchInAllSDNs)
+	if err != nil {
+		return diag.Errorf("Error creating VKCS networking client: %s", err)
+	}
+
+	if err := irbacpolicies.Delete(networkingClient, d.Id()).ExtractErr(); err != nil {
+		return diag.FromErr(util.CheckDeleted(d, err, "Error deleting vkcs_networking_rbac_policy"))
+	}
+
+	return nil
+}
+
+func resourceNetworkingRBACPolicyCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	return networkingRBACPolicyValidateAction(diff.Get("object_type").(string), diff.Get("action").(string))
+}
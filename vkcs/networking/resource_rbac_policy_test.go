@@ -0,0 +1,103 @@
+package networking_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/rbacpolicies"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/acctest"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/clients"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/networking"
+)
+
+func TestAccNetworkingRBACPolicy_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.AccTestPreCheck(t) },
+		ProviderFactories: acctest.AccTestProviders,
+		CheckDestroy:      testAccCheckNetworkingRBACPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.AccTestRenderConfig(testAccNetworkingRBACPolicyBasic),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingRBACPolicyExists("vkcs_networking_rbac_policy.rbac_policy_1"),
+					resource.TestCheckResourceAttr("vkcs_networking_rbac_policy.rbac_policy_1", "object_type", "network"),
+					resource.TestCheckResourceAttr("vkcs_networking_rbac_policy.rbac_policy_1", "action", "access_as_shared"),
+					resource.TestCheckResourceAttr("vkcs_networking_rbac_policy.rbac_policy_1", "target_project_id", acctest.OsProjectID),
+					resource.TestCheckResourceAttrPair("vkcs_networking_rbac_policy.rbac_policy_1", "object_id", "vkcs_networking_network.network_1", "id"),
+				),
+			},
+			{
+				ResourceName:      "vkcs_networking_rbac_policy.rbac_policy_1",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingRBACPolicyExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := acctest.AccTestProvider.Meta().(clients.Config)
+		networkingClient, err := config.NetworkingV2Client(acctest.OsRegionName, networking.DefaultSDN)
+		if err != nil {
+			return fmt.Errorf("Error creating VKCS networking client: %s", err)
+		}
+
+		found, err := rbacpolicies.Get(networkingClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("RBAC policy not found")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckNetworkingRBACPolicyDestroy(s *terraform.State) error {
+	config := acctest.AccTestProvider.Meta().(clients.Config)
+	networkingClient, err := config.NetworkingV2Client(acctest.OsRegionName, networking.DefaultSDN)
+	if err != nil {
+		return fmt.Errorf("Error creating VKCS networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vkcs_networking_rbac_policy" {
+			continue
+		}
+
+		if _, err := rbacpolicies.Get(networkingClient, rs.Primary.ID).Extract(); err == nil {
+			return fmt.Errorf("RBAC policy still exists")
+		}
+	}
+
+	return nil
+}
+
+const testAccNetworkingRBACPolicyBasic = `
+resource "vkcs_networking_network" "network_1" {
+  name = "network_1"
+  sdn  = "neutron"
+}
+
+resource "vkcs_networking_rbac_policy" "rbac_policy_1" {
+  object_type       = "network"
+  object_id         = vkcs_networking_network.network_1.id
+  action            = "access_as_shared"
+  target_project_id = "{{.ProjectID}}"
+  sdn               = "neutron"
+}
+`
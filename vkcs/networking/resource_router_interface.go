@@ -28,6 +28,12 @@ func ResourceNetworkingRouterInterface() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: CheckSDNConsistency(
+			SDNObject{Key: "router_id", Type: SDNObjectRouter},
+			SDNObject{Key: "subnet_id", Type: SDNObjectSubnet},
+			SDNObject{Key: "port_id", Type: SDNObjectPort},
+		),
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
@@ -2,6 +2,7 @@ package networking_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/routers"
@@ -63,6 +64,23 @@ func TestAccNetworkingRouterInterface_basic_port(t *testing.T) {
 	})
 }
 
+func TestAccNetworkingRouterInterface_sdnMismatch(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.AccTestPreCheck(t) },
+		ProviderFactories: acctest.AccTestProviders,
+		CheckDestroy:      testAccCheckNetworkingRouterInterfaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingRouterInterfaceSDNMismatchBase,
+			},
+			{
+				Config:      testAccNetworkingRouterInterfaceSDNMismatch,
+				ExpectError: regexp.MustCompile(`is in "neutron" SDN, but router`),
+			},
+		},
+	})
+}
+
 func TestAccNetworkingRouterInterface_timeout(t *testing.T) {
 	var network networks.Network
 	var router routers.Router
@@ -217,3 +235,28 @@ resource "vkcs_networking_subnet" "subnet_1" {
   network_id = vkcs_networking_network.network_1.id
 }
 `
+
+const testAccNetworkingRouterInterfaceSDNMismatchBase = `
+resource "vkcs_networking_router" "router_1" {
+  name = "router_1"
+  sdn  = "sprut"
+}
+
+resource "vkcs_networking_network" "network_1" {
+  name = "network_1"
+  sdn  = "neutron"
+}
+
+resource "vkcs_networking_subnet" "subnet_1" {
+  cidr       = "192.168.199.0/24"
+  network_id = vkcs_networking_network.network_1.id
+  sdn        = "neutron"
+}
+`
+
+const testAccNetworkingRouterInterfaceSDNMismatch = testAccNetworkingRouterInterfaceSDNMismatchBase + `
+resource "vkcs_networking_router_interface" "int_1" {
+  router_id = vkcs_networking_router.router_1.id
+  subnet_id = vkcs_networking_subnet.subnet_1.id
+}
+`
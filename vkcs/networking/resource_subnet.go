@@ -70,8 +70,9 @@ func ResourceNetworkingSubnet() *schema.Resource {
 				Type:          schema.TypeInt,
 				ConflictsWith: []string{"cidr"},
 				Optional:      true,
+				Computed:      true,
 				ForceNew:      true,
-				Description:   "The prefix length to use when creating a subnet from a subnet pool. The default subnet pool prefix length that was defined when creating the subnet pool will be used if not provided. Changing this creates a new subnet.",
+				Description:   "The prefix length to use when creating a subnet from a subnet pool. The default subnet pool prefix length that was defined when creating the subnet pool will be used if not provided. The allocated CIDR is reported in the `cidr` attribute. Changing this creates a new subnet.",
 			},
 
 			"name": {
@@ -147,7 +148,7 @@ func ResourceNetworkingSubnet() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 				ForceNew:    true,
-				Description: "The ID of the subnetpool associated with the subnet.",
+				Description: "The ID of the subnetpool associated with the subnet. If `cidr` is omitted, the subnet CIDR is allocated from the subnet pool.",
 			},
 
 			"value_specs": {
@@ -320,6 +321,12 @@ func resourceNetworkingSubnetRead(ctx context.Context, d *schema.ResourceData, m
 	d.Set("enable_dhcp", s.EnableDHCP)
 	d.Set("network_id", s.NetworkID)
 	d.Set("subnetpool_id", s.SubnetPoolID)
+	if s.SubnetPoolID != "" {
+		if _, ipNet, err := net.ParseCIDR(s.CIDR); err == nil {
+			prefixLength, _ := ipNet.Mask.Size()
+			d.Set("prefix_length", prefixLength)
+		}
+	}
 	d.Set("enable_private_dns", s.EnablePrivateDNS)
 
 	NetworkingReadAttributesTags(d, s.Tags)
@@ -0,0 +1,303 @@
+package networking
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/clients"
+	inetworking "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/networking"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/util"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/attributestags"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/subnetpools"
+	iattributestags "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/networking/v2/attributestags"
+	isubnetpools "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/networking/v2/subnetpools"
+)
+
+func ResourceNetworkingSubnetPool() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetworkingSubnetPoolCreate,
+		ReadContext:   resourceNetworkingSubnetPoolRead,
+		UpdateContext: resourceNetworkingSubnetPoolUpdate,
+		DeleteContext: resourceNetworkingSubnetPoolDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: resourceNetworkingSubnetPoolCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The region in which to obtain the networking client. A networking client is needed to create a subnet pool. If omitted, the `region` argument of the provider is used. Changing this creates a new subnet pool.",
+			},
+
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the subnet pool. Changing this updates the name of the existing subnet pool.",
+			},
+
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Human-readable description of the subnet pool. Changing this updates the description of the existing subnet pool.",
+			},
+
+			"prefixes": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsCIDRNetwork(0, 32),
+				},
+				Description: "A list of subnet prefixes to assign to the subnet pool. Adjacent prefixes are merged by the networking API and treated as a single prefix. Prefixes can only be added to the existing subnet pool, removing of a prefix fails.",
+			},
+
+			"default_prefix_length": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 32),
+				Description:  "The size of the prefix to allocate when `cidr` and `prefix_length` arguments of `vkcs_networking_subnet` are omitted. Defaults to `min_prefix_length`. Changing this updates the default prefix length of the existing subnet pool.",
+			},
+
+			"min_prefix_length": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 32),
+				Description:  "The smallest prefix that can be allocated from the subnet pool. Changing this updates the minimum prefix length of the existing subnet pool.",
+			},
+
+			"max_prefix_length": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 32),
+				Description:  "The maximum prefix size that can be allocated from the subnet pool. Changing this updates the maximum prefix length of the existing subnet pool.",
+			},
+
+			"address_scope_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the address scope to assign to the subnet pool. Changing this updates the address scope of the existing subnet pool.",
+			},
+
+			"shared": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Indicates whether this subnet pool is shared across all projects. Changing this creates a new subnet pool.",
+			},
+
+			"project_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the project the subnet pool belongs to.",
+			},
+
+			"tags": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "A set of string tags for the subnet pool.",
+			},
+
+			"all_tags": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The collection of tags assigned on the subnet pool, which have been explicitly and implicitly added.",
+			},
+
+			"sdn": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Computed:         true,
+				ValidateDiagFunc: ValidateSDN(),
+				Description:      "SDN to use for this resource. Must be one of following: \"neutron\", \"sprut\". Default value is project's default SDN.",
+			},
+		},
+		Description: "Manages a subnet pool resource within VKCS. Subnets are allocated from the subnet pool with `subnetpool_id` and `prefix_length` arguments of `vkcs_networking_subnet`.",
+	}
+}
+
+func resourceNetworkingSubnetPoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(clients.Config)
+	networkingClient, err := config.NetworkingV2Client(util.GetRegion(d, config), GetSDN(d))
+	if err != nil {
+		return diag.Errorf("Error creating VKCS networking client: %s", err)
+	}
+
+	createOpts := subnetpools.CreateOpts{
+		Name:             d.Get("name").(string),
+		Description:      d.Get("description").(string),
+		Prefixes:         util.ExpandToStringSlice(d.Get("prefixes").([]interface{})),
+		DefaultPrefixLen: d.Get("default_prefix_length").(int),
+		MinPrefixLen:     d.Get("min_prefix_length").(int),
+		MaxPrefixLen:     d.Get("max_prefix_length").(int),
+		AddressScopeID:   d.Get("address_scope_id").(string),
+		Shared:           d.Get("shared").(bool),
+	}
+
+	log.Printf("[DEBUG] vkcs_networking_subnetpool create options: %#v", createOpts)
+
+	p, err := isubnetpools.Create(networkingClient, createOpts).Extract()
+	if err != nil {
+		return diag.Errorf("Error creating vkcs_networking_subnetpool: %s", err)
+	}
+
+	d.SetId(p.ID)
+
+	tags := NetworkingAttributesTags(d)
+	if len(tags) > 0 {
+		tagOpts := attributestags.ReplaceAllOpts{Tags: tags}
+		tags, err := iattributestags.ReplaceAll(networkingClient, "subnetpools", p.ID, tagOpts).Extract()
+		if err != nil {
+			return diag.Errorf("Error setting tags on vkcs_networking_subnetpool %s: %s", p.ID, err)
+		}
+		log.Printf("[DEBUG] Set tags %s on vkcs_networking_subnetpool %s", tags, p.ID)
+	}
+
+	log.Printf("[DEBUG] Created vkcs_networking_subnetpool %s: %#v", p.ID, p)
+	return resourceNetworkingSubnetPoolRead(ctx, d, meta)
+}
+
+func resourceNetworkingSubnetPoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(clients.Config)
+	networkingClient, err := config.NetworkingV2Client(util.GetRegion(d, config), inetworking.SearchInAllSDNs)
+	if err != nil {
+		return diag.Errorf("Error creating VKCS networking client: %s", err)
+	}
+
+	var p subnetPoolExtended
+	err = isubnetpools.ExtractSubnetPoolInto(isubnetpools.Get(networkingClient, d.Id()), &p)
+	if err != nil {
+		return diag.FromErr(util.CheckDeleted(d, err, "Error getting vkcs_networking_subnetpool"))
+	}
+
+	log.Printf("[DEBUG] Retrieved vkcs_networking_subnetpool %s: %#v", d.Id(), p)
+
+	// Keep configured prefixes if the networking API merged them into
+	// the same address space to avoid a permanent diff.
+	prefixes := util.ExpandToStringSlice(d.Get("prefixes").([]interface{}))
+	if !networkingSubnetPoolPrefixesEqual(prefixes, p.Prefixes) {
+		prefixes = p.Prefixes
+	}
+
+	d.Set("name", p.Name)
+	d.Set("description", p.Description)
+	d.Set("prefixes", prefixes)
+	d.Set("default_prefix_length", p.DefaultPrefixLen)
+	d.Set("min_prefix_length", p.MinPrefixLen)
+	d.Set("max_prefix_length", p.MaxPrefixLen)
+	d.Set("address_scope_id", p.AddressScopeID)
+	d.Set("shared", p.Shared)
+	d.Set("project_id", p.ProjectID)
+	d.Set("region", util.GetRegion(d, config))
+	d.Set("sdn", p.SDN)
+
+	NetworkingReadAttributesTags(d, p.Tags)
+
+	return nil
+}
+
+func resourceNetworkingSubnetPoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(clients.Config)
+	networkingClient, err := config.NetworkingV2Client(util.GetRegion(d, config), inetworking.SearchInAllSDNs)
+	if err != nil {
+		return diag.Errorf("Error creating VKCS networking client: %s", err)
+	}
+
+	var hasChange bool
+	var updateOpts subnetpools.UpdateOpts
+
+	if d.HasChange("name") {
+		hasChange = true
+		updateOpts.Name = d.Get("name").(string)
+	}
+
+	if d.HasChange("description") {
+		hasChange = true
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+
+	if d.HasChange("prefixes") {
+		hasChange = true
+		updateOpts.Prefixes = util.ExpandToStringSlice(d.Get("prefixes").([]interface{}))
+	}
+
+	if d.HasChange("default_prefix_length") {
+		hasChange = true
+		updateOpts.DefaultPrefixLen = d.Get("default_prefix_length").(int)
+	}
+
+	if d.HasChange("min_prefix_length") {
+		hasChange = true
+		updateOpts.MinPrefixLen = d.Get("min_prefix_length").(int)
+	}
+
+	if d.HasChange("max_prefix_length") {
+		hasChange = true
+		updateOpts.MaxPrefixLen = d.Get("max_prefix_length").(int)
+	}
+
+	if d.HasChange("address_scope_id") {
+		hasChange = true
+		addressScopeID := d.Get("address_scope_id").(string)
+		updateOpts.AddressScopeID = &addressScopeID
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] vkcs_networking_subnetpool %s update options: %#v", d.Id(), updateOpts)
+		_, err = isubnetpools.Update(networkingClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return diag.Errorf("Error updating vkcs_networking_subnetpool %s: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		tags := NetworkingV2UpdateAttributesTags(d)
+		tagOpts := attributestags.ReplaceAllOpts{Tags: tags}
+		tags, err := iattributestags.ReplaceAll(networkingClient, "subnetpools", d.Id(), tagOpts).Extract()
+		if err != nil {
+			return diag.Errorf("Error setting tags on vkcs_networking_subnetpool %s: %s", d.Id(), err)
+		}
+		log.Printf("[DEBUG] Set tags %s on vkcs_networking_subnetpool %s", tags, d.Id())
+	}
+
+	return resourceNetworkingSubnetPoolRead(ctx, d, meta)
+}
+
+func resourceNetworkingSubnetPoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(clients.Config)
+	networkingClient, err := config.NetworkingV2Client(util.GetRegion(d, config), inetworking.SearchInAllSDNs)
+	if err != nil {
+		return diag.Errorf("Error creating VKCS networking client: %s", err)
+	}
+
+	if err := isubnetpools.Delete(networkingClient, d.Id()).ExtractErr(); err != nil {
+		return diag.FromErr(util.CheckDeleted(d, err, "Error deleting vkcs_networking_subnetpool"))
+	}
+
+	return nil
+}
+
+func resourceNetworkingSubnetPoolCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	return networkingSubnetPoolValidatePrefixLengths(
+		diff.Get("min_prefix_length").(int),
+		diff.Get("default_prefix_length").(int),
+		diff.Get("max_prefix_length").(int),
+	)
+}
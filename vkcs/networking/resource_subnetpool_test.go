@@ -0,0 +1,165 @@
+package networking_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/subnetpools"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/acctest"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/clients"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/networking"
+)
+
+func TestAccNetworkingSubnetPool_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.AccTestPreCheck(t) },
+		ProviderFactories: acctest.AccTestProviders,
+		CheckDestroy:      testAccCheckNetworkingSubnetPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingSubnetPoolBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingSubnetPoolExists("vkcs_networking_subnetpool.subnetpool_1"),
+					resource.TestCheckResourceAttr("vkcs_networking_subnetpool.subnetpool_1", "name", "subnetpool_1"),
+					resource.TestCheckResourceAttr("vkcs_networking_subnetpool.subnetpool_1", "default_prefix_length", "24"),
+					resource.TestCheckResourceAttr("vkcs_networking_subnet.subnet_1", "cidr", "10.10.0.0/24"),
+					resource.TestCheckResourceAttr("vkcs_networking_subnet.subnet_1", "prefix_length", "24"),
+					resource.TestCheckResourceAttr("vkcs_networking_subnet.subnet_2", "prefix_length", "26"),
+				),
+			},
+			{
+				Config: testAccNetworkingSubnetPoolUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vkcs_networking_subnetpool.subnetpool_1", "name", "subnetpool_1_updated"),
+					resource.TestCheckResourceAttr("vkcs_networking_subnetpool.subnetpool_1", "prefixes.#", "2"),
+					resource.TestCheckResourceAttr("vkcs_networking_subnetpool.subnetpool_1", "max_prefix_length", "27"),
+				),
+			},
+			{
+				ResourceName:      "vkcs_networking_subnetpool.subnetpool_1",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingSubnetPoolExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := acctest.AccTestProvider.Meta().(clients.Config)
+		networkingClient, err := config.NetworkingV2Client(acctest.OsRegionName, networking.DefaultSDN)
+		if err != nil {
+			return fmt.Errorf("Error creating VKCS networking client: %s", err)
+		}
+
+		found, err := subnetpools.Get(networkingClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Subnet pool not found")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckNetworkingSubnetPoolDestroy(s *terraform.State) error {
+	config := acctest.AccTestProvider.Meta().(clients.Config)
+	networkingClient, err := config.NetworkingV2Client(acctest.OsRegionName, networking.DefaultSDN)
+	if err != nil {
+		return fmt.Errorf("Error creating VKCS networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vkcs_networking_subnetpool" {
+			continue
+		}
+
+		if _, err := subnetpools.Get(networkingClient, rs.Primary.ID).Extract(); err == nil {
+			return fmt.Errorf("Subnet pool still exists")
+		}
+	}
+
+	return nil
+}
+
+const testAccNetworkingSubnetPoolBasic = `
+resource "vkcs_networking_subnetpool" "subnetpool_1" {
+  name                  = "subnetpool_1"
+  description           = "terraform acceptance test"
+  prefixes              = ["10.10.0.0/16"]
+  default_prefix_length = 24
+  min_prefix_length     = 16
+  max_prefix_length     = 28
+  sdn                   = "neutron"
+}
+
+resource "vkcs_networking_network" "network_1" {
+  name = "network_1"
+  sdn  = "neutron"
+}
+
+resource "vkcs_networking_subnet" "subnet_1" {
+  name          = "subnet_1"
+  network_id    = vkcs_networking_network.network_1.id
+  subnetpool_id = vkcs_networking_subnetpool.subnetpool_1.id
+  sdn           = "neutron"
+}
+
+resource "vkcs_networking_subnet" "subnet_2" {
+  name          = "subnet_2"
+  network_id    = vkcs_networking_network.network_1.id
+  subnetpool_id = vkcs_networking_subnetpool.subnetpool_1.id
+  prefix_length = 26
+  sdn           = "neutron"
+
+  depends_on = [vkcs_networking_subnet.subnet_1]
+}
+`
+
+const testAccNetworkingSubnetPoolUpdate = `
+resource "vkcs_networking_subnetpool" "subnetpool_1" {
+  name                  = "subnetpool_1_updated"
+  description           = "terraform acceptance test"
+  prefixes              = ["10.10.0.0/16", "10.20.0.0/16"]
+  default_prefix_length = 24
+  min_prefix_length     = 16
+  max_prefix_length     = 27
+  sdn                   = "neutron"
+}
+
+resource "vkcs_networking_network" "network_1" {
+  name = "network_1"
+  sdn  = "neutron"
+}
+
+resource "vkcs_networking_subnet" "subnet_1" {
+  name          = "subnet_1"
+  network_id    = vkcs_networking_network.network_1.id
+  subnetpool_id = vkcs_networking_subnetpool.subnetpool_1.id
+  sdn           = "neutron"
+}
+
+resource "vkcs_networking_subnet" "subnet_2" {
+  name          = "subnet_2"
+  network_id    = vkcs_networking_network.network_1.id
+  subnetpool_id = vkcs_networking_subnetpool.subnetpool_1.id
+  prefix_length = 26
+  sdn           = "neutron"
+
+  depends_on = [vkcs_networking_subnet.subnet_1]
+}
+`
@@ -0,0 +1,352 @@
+package networking
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/clients"
+	inetworking "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/networking"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/util"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/attributestags"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/trunks"
+	iattributestags "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/networking/v2/attributestags"
+	iports "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/networking/v2/ports"
+)
+
+func ResourceNetworkingTrunk() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetworkingTrunkCreate,
+		ReadContext:   resourceNetworkingTrunkRead,
+		UpdateContext: resourceNetworkingTrunkUpdate,
+		DeleteContext: resourceNetworkingTrunkDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: resourceNetworkingTrunkCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The region in which to obtain the networking client. A networking client is needed to create a trunk. If omitted, the `region` argument of the provider is used. Changing this creates a new trunk.",
+			},
+
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A unique name for the trunk. Changing this updates the `name` of an existing trunk.",
+			},
+
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Human-readable description of the trunk. Changing this updates the `description` of an existing trunk.",
+			},
+
+			"port_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the parent port to be used as the trunk. The parent port can be attached to an instance through `network.port` of `vkcs_compute_instance`. Changing this creates a new trunk.",
+			},
+
+			"admin_state_up": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Administrative up/down status for the trunk (must be \"true\" or \"false\" if provided). Changing this updates the `admin_state_up` of an existing trunk.",
+			},
+
+			"sub_port": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"port_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The ID of the port to be made a sub-port of the trunk.",
+						},
+
+						"segmentation_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(trunkSegmentationTypes, false),
+							Description:  "The segmentation technology to use. Must be one of \"vlan\", \"inherit\".",
+						},
+
+						"segmentation_id": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 4094),
+							Description:  "The numeric ID of the subport segmentation, e.g. VLAN ID. Must be between 1 and 4094. Required for \"vlan\" segmentation type, must not be set for \"inherit\" segmentation type.",
+						},
+					},
+				},
+				Description: "The set of ports that will be made sub-ports of the trunk. Sub-ports are added to or removed from the existing trunk in place.",
+			},
+
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the trunk.",
+			},
+
+			"tags": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "A set of string tags for the trunk.",
+			},
+
+			"all_tags": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The collection of tags assigned on the trunk, which have been explicitly and implicitly added.",
+			},
+
+			"sdn": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Computed:         true,
+				ValidateDiagFunc: ValidateSDN(),
+				Description:      "SDN to use for this resource. Must be one of following: \"neutron\", \"sprut\". Default value is SDN of the parent port.",
+			},
+		},
+		Description: "Manages a trunk resource within VKCS. A trunk allows to connect an instance to several networks through a single parent port using VLAN tagged sub-ports.",
+	}
+}
+
+func resourceNetworkingTrunkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(clients.Config)
+	region := util.GetRegion(d, config)
+	portID := d.Get("port_id").(string)
+
+	sdn := GetSDN(d)
+	if sdn == inetworking.SearchInAllSDNs {
+		searchClient, err := config.NetworkingV2Client(region, inetworking.SearchInAllSDNs)
+		if err != nil {
+			return diag.Errorf("Error creating VKCS networking client: %s", err)
+		}
+
+		sdn, err = networkingTrunkParentPortSDN(searchClient, portID)
+		if err != nil {
+			return diag.Errorf("Error getting parent port %s of vkcs_networking_trunk: %s", portID, err)
+		}
+	}
+
+	networkingClient, err := config.NetworkingV2Client(region, sdn)
+	if err != nil {
+		return diag.Errorf("Error creating VKCS networking client: %s", err)
+	}
+
+	adminStateUp := d.Get("admin_state_up").(bool)
+	createOpts := iports.CreateTrunkOpts{
+		Name:         d.Get("name").(string),
+		Description:  d.Get("description").(string),
+		PortID:       portID,
+		AdminStateUp: &adminStateUp,
+		Subports:     expandNetworkingTrunkSubports(d.Get("sub_port").(*schema.Set)),
+	}
+
+	log.Printf("[DEBUG] vkcs_networking_trunk create options: %#v", createOpts)
+
+	trunk, err := iports.CreateTrunk(networkingClient, createOpts).Extract()
+	if err != nil {
+		return diag.Errorf("Error creating vkcs_networking_trunk: %s", err)
+	}
+
+	// Store the ID now, so the trunk is not lost if waiting for it fails.
+	d.SetId(trunk.ID)
+
+	log.Printf("[DEBUG] Waiting for vkcs_networking_trunk %s to become available.", trunk.ID)
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"BUILD"},
+		Target:     []string{"ACTIVE", "DOWN"},
+		Refresh:    resourceNetworkingTrunkStateRefreshFunc(networkingClient, trunk.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("Error waiting for vkcs_networking_trunk %s to become available: %s", trunk.ID, err)
+	}
+
+	tags := NetworkingAttributesTags(d)
+	if len(tags) > 0 {
+		tagOpts := attributestags.ReplaceAllOpts{Tags: tags}
+		tags, err := iattributestags.ReplaceAll(networkingClient, "trunks", trunk.ID, tagOpts).Extract()
+		if err != nil {
+			return diag.Errorf("Error setting tags on vkcs_networking_trunk %s: %s", trunk.ID, err)
+		}
+		log.Printf("[DEBUG] Set tags %s on vkcs_networking_trunk %s", tags, trunk.ID)
+	}
+
+	log.Printf("[DEBUG] Created vkcs_networking_trunk %s: %#v", trunk.ID, trunk)
+	return resourceNetworkingTrunkRead(ctx, d, meta)
+}
+
+func resourceNetworkingTrunkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(clients.Config)
+	networkingClient, err := config.NetworkingV2Client(util.GetRegion(d, config), inetworking.SearchInAllSDNs)
+	if err != nil {
+		return diag.Errorf("Error creating VKCS networking client: %s", err)
+	}
+
+	var trunk trunkExtended
+	err = iports.ExtractTrunkInto(iports.GetTrunk(networkingClient, d.Id()), &trunk)
+	if err != nil {
+		return diag.FromErr(util.CheckDeleted(d, err, "Error getting vkcs_networking_trunk"))
+	}
+
+	log.Printf("[DEBUG] Retrieved vkcs_networking_trunk %s: %#v", d.Id(), trunk)
+
+	d.Set("name", trunk.Name)
+	d.Set("description", trunk.Description)
+	d.Set("port_id", trunk.PortID)
+	d.Set("admin_state_up", trunk.AdminStateUp)
+	d.Set("status", trunk.Status)
+	d.Set("sub_port", flattenNetworkingTrunkSubports(trunk.Subports))
+	d.Set("region", util.GetRegion(d, config))
+	d.Set("sdn", trunk.SDN)
+
+	NetworkingReadAttributesTags(d, trunk.Tags)
+
+	return nil
+}
+
+func resourceNetworkingTrunkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(clients.Config)
+	networkingClient, err := config.NetworkingV2Client(util.GetRegion(d, config), inetworking.SearchInAllSDNs)
+	if err != nil {
+		return diag.Errorf("Error creating VKCS networking client: %s", err)
+	}
+
+	var hasChange bool
+	var updateOpts trunks.UpdateOpts
+
+	if d.HasChange("name") {
+		hasChange = true
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+
+	if d.HasChange("description") {
+		hasChange = true
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+
+	if d.HasChange("admin_state_up") {
+		hasChange = true
+		adminStateUp := d.Get("admin_state_up").(bool)
+		updateOpts.AdminStateUp = &adminStateUp
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] vkcs_networking_trunk %s update options: %#v", d.Id(), updateOpts)
+		_, err = iports.UpdateTrunk(networkingClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return diag.Errorf("Error updating vkcs_networking_trunk %s: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("sub_port") {
+		o, n := d.GetChange("sub_port")
+		oldSubports, newSubports := o.(*schema.Set), n.(*schema.Set)
+
+		// Sub-ports are removed first, so that a port can be re-added with
+		// another segmentation within a single apply.
+		toRemove := oldSubports.Difference(newSubports)
+		if toRemove.Len() > 0 {
+			removeOpts := trunks.RemoveSubportsOpts{
+				Subports: expandNetworkingTrunkSubportsRemove(toRemove),
+			}
+			log.Printf("[DEBUG] vkcs_networking_trunk %s remove subports options: %#v", d.Id(), removeOpts)
+			_, err = iports.RemoveSubports(networkingClient, d.Id(), removeOpts).Extract()
+			if err != nil {
+				return diag.Errorf("Error removing subports from vkcs_networking_trunk %s: %s", d.Id(), err)
+			}
+		}
+
+		toAdd := newSubports.Difference(oldSubports)
+		if toAdd.Len() > 0 {
+			addOpts := iports.AddSubportsOpts{
+				Subports: expandNetworkingTrunkSubports(toAdd),
+			}
+			log.Printf("[DEBUG] vkcs_networking_trunk %s add subports options: %#v", d.Id(), addOpts)
+			_, err = iports.AddSubports(networkingClient, d.Id(), addOpts).Extract()
+			if err != nil {
+				return diag.Errorf("Error adding subports to vkcs_networking_trunk %s: %s", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("tags") {
+		tags := NetworkingV2UpdateAttributesTags(d)
+		tagOpts := attributestags.ReplaceAllOpts{Tags: tags}
+		tags, err := iattributestags.ReplaceAll(networkingClient, "trunks", d.Id(), tagOpts).Extract()
+		if err != nil {
+			return diag.Errorf("Error setting tags on vkcs_networking_trunk %s: %s", d.Id(), err)
+		}
+		log.Printf("[DEBUG] Set tags %s on vkcs_networking_trunk %s", tags, d.Id())
+	}
+
+	return resourceNetworkingTrunkRead(ctx, d, meta)
+}
+
+func resourceNetworkingTrunkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(clients.Config)
+	networkingClient, err := config.NetworkingV2Client(util.GetRegion(d, config), inetworking.SearchInAllSDNs)
+	if err != nil {
+		return diag.Errorf("Error creating VKCS networking client: %s", err)
+	}
+
+	if err := iports.DeleteTrunk(networkingClient, d.Id()).ExtractErr(); err != nil {
+		return diag.FromErr(util.CheckDeleted(d, err, "Error deleting vkcs_networking_trunk"))
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"ACTIVE", "DOWN", "BUILD"},
+		Target:     []string{"DELETED"},
+		Refresh:    resourceNetworkingTrunkStateRefreshFunc(networkingClient, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("Error waiting for vkcs_networking_trunk %s to delete: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceNetworkingTrunkCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("sub_port") {
+		return nil
+	}
+	return checkNetworkingTrunkSubports(diff.Get("sub_port").(*schema.Set))
+}
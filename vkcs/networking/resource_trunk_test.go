@@ -0,0 +1,187 @@
+package networking_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/trunks"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/acctest"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/clients"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/networking"
+)
+
+func TestAccNetworkingTrunk_basic(t *testing.T) {
+	var trunk trunks.Trunk
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.AccTestPreCheck(t) },
+		ProviderFactories: acctest.AccTestProviders,
+		CheckDestroy:      testAccCheckNetworkingTrunkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.AccTestRenderConfig(testAccNetworkingTrunkBasic),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingTrunkExists("vkcs_networking_trunk.trunk_1", &trunk),
+					resource.TestCheckResourceAttr("vkcs_networking_trunk.trunk_1", "name", "trunk_1"),
+					resource.TestCheckResourceAttr("vkcs_networking_trunk.trunk_1", "sub_port.#", "1"),
+					resource.TestCheckResourceAttrPair("vkcs_networking_trunk.trunk_1", "port_id", "vkcs_networking_port.parent_port_1", "id"),
+					resource.TestCheckResourceAttr("vkcs_networking_trunk.trunk_1", "sdn", "neutron"),
+				),
+			},
+			{
+				Config: acctest.AccTestRenderConfig(testAccNetworkingTrunkUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingTrunkExists("vkcs_networking_trunk.trunk_1", &trunk),
+					resource.TestCheckResourceAttr("vkcs_networking_trunk.trunk_1", "name", "trunk_1_updated"),
+					resource.TestCheckResourceAttr("vkcs_networking_trunk.trunk_1", "sub_port.#", "2"),
+					testAccCheckNetworkingTrunkSubportCount(&trunk, 2),
+				),
+			},
+			{
+				ResourceName:      "vkcs_networking_trunk.trunk_1",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingTrunkExists(n string, trunk *trunks.Trunk) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := acctest.AccTestProvider.Meta().(clients.Config)
+		networkingClient, err := config.NetworkingV2Client(acctest.OsRegionName, networking.DefaultSDN)
+		if err != nil {
+			return fmt.Errorf("Error creating VKCS networking client: %s", err)
+		}
+
+		found, err := trunks.Get(networkingClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Trunk not found")
+		}
+
+		*trunk = *found
+
+		return nil
+	}
+}
+
+func testAccCheckNetworkingTrunkSubportCount(trunk *trunks.Trunk, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if len(trunk.Subports) != expected {
+			return fmt.Errorf("Expected %d subports, got %d", expected, len(trunk.Subports))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckNetworkingTrunkDestroy(s *terraform.State) error {
+	config := acctest.AccTestProvider.Meta().(clients.Config)
+	networkingClient, err := config.NetworkingV2Client(acctest.OsRegionName, networking.DefaultSDN)
+	if err != nil {
+		return fmt.Errorf("Error creating VKCS networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vkcs_networking_trunk" {
+			continue
+		}
+
+		if _, err := trunks.Get(networkingClient, rs.Primary.ID).Extract(); err == nil {
+			return fmt.Errorf("Trunk still exists")
+		}
+	}
+
+	return nil
+}
+
+const testAccNetworkingTrunkBase = `
+resource "vkcs_networking_network" "network_1" {
+  name = "network_1"
+  sdn  = "neutron"
+}
+
+resource "vkcs_networking_subnet" "subnet_1" {
+  name       = "subnet_1"
+  cidr       = "192.168.199.0/24"
+  network_id = vkcs_networking_network.network_1.id
+  sdn        = "neutron"
+}
+
+resource "vkcs_networking_network" "network_2" {
+  name = "network_2"
+  sdn  = "neutron"
+}
+
+resource "vkcs_networking_subnet" "subnet_2" {
+  name       = "subnet_2"
+  cidr       = "192.168.200.0/24"
+  network_id = vkcs_networking_network.network_2.id
+  sdn        = "neutron"
+}
+
+resource "vkcs_networking_port" "parent_port_1" {
+  name       = "parent_port_1"
+  network_id = vkcs_networking_subnet.subnet_1.network_id
+  sdn        = "neutron"
+}
+
+resource "vkcs_networking_port" "subport_1" {
+  name       = "subport_1"
+  network_id = vkcs_networking_subnet.subnet_2.network_id
+  sdn        = "neutron"
+}
+
+resource "vkcs_networking_port" "subport_2" {
+  name       = "subport_2"
+  network_id = vkcs_networking_subnet.subnet_2.network_id
+  sdn        = "neutron"
+}
+`
+
+const testAccNetworkingTrunkBasic = testAccNetworkingTrunkBase + `
+resource "vkcs_networking_trunk" "trunk_1" {
+  name    = "trunk_1"
+  port_id = vkcs_networking_port.parent_port_1.id
+
+  sub_port {
+    port_id           = vkcs_networking_port.subport_1.id
+    segmentation_type = "vlan"
+    segmentation_id   = 100
+  }
+}
+`
+
+const testAccNetworkingTrunkUpdate = testAccNetworkingTrunkBase + `
+resource "vkcs_networking_trunk" "trunk_1" {
+  name    = "trunk_1_updated"
+  port_id = vkcs_networking_port.parent_port_1.id
+
+  sub_port {
+    port_id           = vkcs_networking_port.subport_1.id
+    segmentation_type = "vlan"
+    segmentation_id   = 100
+  }
+
+  sub_port {
+    port_id           = vkcs_networking_port.subport_2.id
+    segmentation_type = "vlan"
+    segmentation_id   = 200
+  }
+}
+`
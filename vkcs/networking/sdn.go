@@ -0,0 +1,188 @@
+package networking
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/clients"
+	inetworking "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/networking"
+	ifloatingips "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/networking/v2/floatingips"
+	inetworks "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/networking/v2/networks"
+	iports "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/networking/v2/ports"
+	irouters "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/networking/v2/routers"
+	isubnets "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/networking/v2/subnets"
+)
+
+// Types of networking objects which SDN can be resolved by SDNObject.
+const (
+	SDNObjectNetwork           = "network"
+	SDNObjectSubnet            = "subnet"
+	SDNObjectRouter            = "router"
+	SDNObjectPort              = "port"
+	SDNObjectFloatingIP        = "floatingip"
+	SDNObjectFloatingIPAddress = "floatingip_address"
+)
+
+// SDNObject is a networking object referenced by the resource attribute Key.
+type SDNObject struct {
+	Key  string
+	Type string
+}
+
+type sdnObjectRef struct {
+	key     string
+	objType string
+	id      string
+	sdn     string
+}
+
+func (r sdnObjectRef) String() string {
+	objType := r.objType
+	if objType == SDNObjectFloatingIPAddress {
+		objType = SDNObjectFloatingIP
+	}
+	return fmt.Sprintf("%s %q (%s)", objType, r.id, r.key)
+}
+
+// CheckSDNConsistency returns a CustomizeDiff function, which verifies that
+// networking objects referenced by the resource belong to the same SDN and
+// match the resource `sdn` argument if the resource has one. Only objects
+// with known IDs are checked, so the check is skipped for objects created
+// within the same apply.
+func CheckSDNConsistency(objects ...SDNObject) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		return ValidateSDNConsistency(diff, meta, objects)
+	}
+}
+
+// ValidateSDNConsistency is CheckSDNConsistency for resources, which
+// reference a variable number of networking objects.
+func ValidateSDNConsistency(diff *schema.ResourceDiff, meta interface{}, objects []SDNObject) error {
+	// sdn is empty for resources without `sdn` argument.
+	var sdn string
+	if diff.NewValueKnown("sdn") {
+		v, _ := diff.Get("sdn").(string)
+		sdn = strings.ToLower(v)
+	}
+
+	var changed bool
+	var refs []sdnObjectRef
+	for _, obj := range objects {
+		if !diff.NewValueKnown(obj.Key) {
+			continue
+		}
+		id, _ := diff.Get(obj.Key).(string)
+		if id == "" {
+			continue
+		}
+		if diff.Id() == "" || diff.HasChange(obj.Key) {
+			changed = true
+		}
+		refs = append(refs, sdnObjectRef{key: obj.Key, objType: obj.Type, id: id})
+	}
+
+	if !changed || len(refs) == 0 || (sdn == "" && len(refs) < 2) {
+		return nil
+	}
+
+	config := meta.(clients.Config)
+	region, _ := diff.Get("region").(string)
+	if region == "" {
+		region = config.GetRegion()
+	}
+
+	client, err := config.NetworkingV2Client(region, inetworking.SearchInAllSDNs)
+	if err != nil {
+		return fmt.Errorf("error creating VKCS networking client: %s", err)
+	}
+
+	available, err := inetworking.GetAvailableSDNs(client)
+	if err != nil {
+		log.Printf("[DEBUG] Skipping SDN consistency check: %s", err)
+		return nil
+	}
+
+	if sdn != "" && !networkingSDNAvailable(available, sdn) {
+		return fmt.Errorf("sdn %q is not available in the project, available SDNs: %s", sdn, strings.Join(available, ", "))
+	}
+
+	// All objects belong to the only SDN of the project.
+	if len(available) < 2 {
+		return nil
+	}
+
+	resolved := make([]sdnObjectRef, 0, len(refs))
+	for _, ref := range refs {
+		ref.sdn, err = networkingObjectSDN(client, ref.objType, ref.id)
+		if err != nil {
+			// Missing objects are reported by the API on apply.
+			log.Printf("[DEBUG] Unable to resolve SDN of %s: %s", ref, err)
+			continue
+		}
+		resolved = append(resolved, ref)
+	}
+
+	return networkingCheckSDNObjects(sdn, resolved)
+}
+
+func networkingCheckSDNObjects(sdn string, refs []sdnObjectRef) error {
+	for i := 1; i < len(refs); i++ {
+		if refs[i].sdn != refs[0].sdn {
+			return fmt.Errorf("%s is in %q SDN, but %s is in %q SDN: referenced networking objects must belong to the same SDN",
+				refs[i], refs[i].sdn, refs[0], refs[0].sdn)
+		}
+	}
+
+	if sdn != "" && len(refs) > 0 && refs[0].sdn != sdn {
+		return fmt.Errorf("%s is in %q SDN, but the resource is created in %q SDN: set `sdn` argument to %q",
+			refs[0], refs[0].sdn, sdn, refs[0].sdn)
+	}
+
+	return nil
+}
+
+func networkingSDNAvailable(available []string, sdn string) bool {
+	for _, s := range available {
+		if s == sdn {
+			return true
+		}
+	}
+	return false
+}
+
+func networkingObjectSDN(client *gophercloud.ServiceClient, objType, id string) (string, error) {
+	var obj inetworking.SDNExt
+	var err error
+
+	switch objType {
+	case SDNObjectNetwork:
+		err = inetworks.Get(client, id).ExtractIntoStructPtr(&obj, "network")
+	case SDNObjectSubnet:
+		err = isubnets.Get(client, id).ExtractIntoStructPtr(&obj, "subnet")
+	case SDNObjectRouter:
+		err = irouters.Get(client, id).ExtractIntoStructPtr(&obj, "router")
+	case SDNObjectPort:
+		err = iports.Get(client, id).ExtractIntoStructPtr(&obj, "port")
+	case SDNObjectFloatingIP:
+		err = ifloatingips.Get(client, id).ExtractIntoStructPtr(&obj, "floatingip")
+	case SDNObjectFloatingIPAddress:
+		var fipID string
+		fipID, err = networkingFloatingIPV2ID(client, id)
+		if err != nil {
+			return "", err
+		}
+		return networkingObjectSDN(client, SDNObjectFloatingIP, fipID)
+	default:
+		return "", fmt.Errorf("unsupported networking object type %q", objType)
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	return strings.ToLower(obj.SDN), nil
+}
@@ -0,0 +1,28 @@
+package networking
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetworkingCheckSDNObjects(t *testing.T) {
+	router := sdnObjectRef{key: "router_id", objType: SDNObjectRouter, id: "router_1", sdn: "sprut"}
+	subnet := sdnObjectRef{key: "subnet_id", objType: SDNObjectSubnet, id: "subnet_1", sdn: "neutron"}
+	port := sdnObjectRef{key: "port_id", objType: SDNObjectPort, id: "port_1", sdn: "sprut"}
+
+	assert.NoError(t, networkingCheckSDNObjects("", []sdnObjectRef{router, port}))
+	assert.NoError(t, networkingCheckSDNObjects("sprut", []sdnObjectRef{router, port}))
+	assert.NoError(t, networkingCheckSDNObjects("neutron", nil))
+
+	err := networkingCheckSDNObjects("", []sdnObjectRef{router, subnet})
+	assert.EqualError(t, err, `subnet "subnet_1" (subnet_id) is in "neutron" SDN, but router "router_1" (router_id) is in "sprut" SDN: referenced networking objects must belong to the same SDN`)
+
+	err = networkingCheckSDNObjects("neutron", []sdnObjectRef{router, port})
+	assert.EqualError(t, err, `router "router_1" (router_id) is in "sprut" SDN, but the resource is created in "neutron" SDN: set `+"`sdn`"+` argument to "sprut"`)
+}
+
+func TestSDNObjectRefString(t *testing.T) {
+	ref := sdnObjectRef{key: "floating_ip", objType: SDNObjectFloatingIPAddress, id: "1.2.3.4"}
+	assert.Equal(t, `floatingip "1.2.3.4" (floating_ip)`, ref.String())
+}
@@ -0,0 +1,103 @@
+package networking
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"sort"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/subnetpools"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/networking"
+)
+
+type subnetPoolExtended struct {
+	subnetpools.SubnetPool
+	networking.SDNExt
+}
+
+// networkingSubnetPoolValidatePrefixLengths checks that the default prefix
+// length of a subnet pool lies between the minimum and maximum ones. Zero
+// values are skipped since they are defined by the networking API.
+func networkingSubnetPoolValidatePrefixLengths(minPrefixLen, defaultPrefixLen, maxPrefixLen int) error {
+	if minPrefixLen != 0 && maxPrefixLen != 0 && minPrefixLen > maxPrefixLen {
+		return fmt.Errorf("min_prefix_length %d must not be greater than max_prefix_length %d", minPrefixLen, maxPrefixLen)
+	}
+	if defaultPrefixLen == 0 {
+		return nil
+	}
+	if minPrefixLen != 0 && defaultPrefixLen < minPrefixLen {
+		return fmt.Errorf("default_prefix_length %d must not be less than min_prefix_length %d", defaultPrefixLen, minPrefixLen)
+	}
+	if maxPrefixLen != 0 && defaultPrefixLen > maxPrefixLen {
+		return fmt.Errorf("default_prefix_length %d must not be greater than max_prefix_length %d", defaultPrefixLen, maxPrefixLen)
+	}
+	return nil
+}
+
+type subnetPoolAddressRange struct {
+	start uint64
+	end   uint64
+}
+
+// networkingSubnetPoolMergePrefixes converts IPv4 prefixes to sorted address
+// ranges merging adjacent and overlapping ones.
+func networkingSubnetPoolMergePrefixes(prefixes []string) ([]subnetPoolAddressRange, error) {
+	ranges := make([]subnetPoolAddressRange, 0, len(prefixes))
+	for _, prefix := range prefixes {
+		_, ipNet, err := net.ParseCIDR(prefix)
+		if err != nil {
+			return nil, err
+		}
+		ip := ipNet.IP.To4()
+		if ip == nil {
+			return nil, fmt.Errorf("%s is not an IPv4 prefix", prefix)
+		}
+		ones, bits := ipNet.Mask.Size()
+		start := uint64(binary.BigEndian.Uint32(ip))
+		ranges = append(ranges, subnetPoolAddressRange{
+			start: start,
+			end:   start + (uint64(1) << (bits - ones)) - 1,
+		})
+	}
+
+	sort.Slice(ranges, func(i, j int) bool {
+		return ranges[i].start < ranges[j].start
+	})
+
+	var merged []subnetPoolAddressRange
+	for _, r := range ranges {
+		if n := len(merged); n > 0 && r.start <= merged[n-1].end+1 {
+			if r.end > merged[n-1].end {
+				merged[n-1].end = r.end
+			}
+			continue
+		}
+		merged = append(merged, r)
+	}
+
+	return merged, nil
+}
+
+// networkingSubnetPoolPrefixesEqual reports whether two lists of prefixes
+// cover the same address space.
+func networkingSubnetPoolPrefixesEqual(a, b []string) bool {
+	rangesA, err := networkingSubnetPoolMergePrefixes(a)
+	if err != nil {
+		return false
+	}
+	rangesB, err := networkingSubnetPoolMergePrefixes(b)
+	if err != nil {
+		return false
+	}
+
+	if len(rangesA) != len(rangesB) {
+		return false
+	}
+	for i := range rangesA {
+		if rangesA[i] != rangesB[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,29 @@
+package networking
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetworkingSubnetPoolPrefixesEqual(t *testing.T) {
+	assert.True(t, networkingSubnetPoolPrefixesEqual([]string{"10.10.0.0/16"}, []string{"10.10.0.0/16"}))
+	assert.True(t, networkingSubnetPoolPrefixesEqual([]string{"10.0.0.0/24", "10.0.1.0/24"}, []string{"10.0.0.0/23"}))
+	assert.True(t, networkingSubnetPoolPrefixesEqual([]string{"10.20.0.0/16", "10.10.0.0/16"}, []string{"10.10.0.0/16", "10.20.0.0/16"}))
+	assert.True(t, networkingSubnetPoolPrefixesEqual(nil, nil))
+
+	assert.False(t, networkingSubnetPoolPrefixesEqual([]string{"10.0.0.0/24"}, []string{"10.0.0.0/23"}))
+	assert.False(t, networkingSubnetPoolPrefixesEqual([]string{"10.0.0.0/24", "10.0.2.0/24"}, []string{"10.0.0.0/22"}))
+	assert.False(t, networkingSubnetPoolPrefixesEqual(nil, []string{"10.0.0.0/16"}))
+	assert.False(t, networkingSubnetPoolPrefixesEqual([]string{"invalid"}, []string{"invalid"}))
+}
+
+func TestNetworkingSubnetPoolValidatePrefixLengths(t *testing.T) {
+	assert.NoError(t, networkingSubnetPoolValidatePrefixLengths(0, 0, 0))
+	assert.NoError(t, networkingSubnetPoolValidatePrefixLengths(16, 24, 28))
+	assert.NoError(t, networkingSubnetPoolValidatePrefixLengths(0, 24, 0))
+
+	assert.Error(t, networkingSubnetPoolValidatePrefixLengths(28, 0, 24))
+	assert.Error(t, networkingSubnetPoolValidatePrefixLengths(24, 16, 28))
+	assert.Error(t, networkingSubnetPoolValidatePrefixLengths(16, 30, 28))
+}
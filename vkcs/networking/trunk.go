@@ -0,0 +1,117 @@
+package networking
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/trunks"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/networking"
+	iports "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/networking/v2/ports"
+	"github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/util/errutil"
+)
+
+const (
+	trunkSegmentationTypeVLAN    = "vlan"
+	trunkSegmentationTypeInherit = "inherit"
+)
+
+var trunkSegmentationTypes = []string{
+	trunkSegmentationTypeVLAN,
+	trunkSegmentationTypeInherit,
+}
+
+// checkNetworkingTrunkSubports checks that segmentation ID of each sub-port
+// matches its segmentation type.
+func checkNetworkingTrunkSubports(subports *schema.Set) error {
+	for _, v := range subports.List() {
+		m := v.(map[string]interface{})
+		portID, segmentationType, segmentationID := m["port_id"].(string), m["segmentation_type"].(string), m["segmentation_id"].(int)
+		switch {
+		case segmentationType == trunkSegmentationTypeInherit && segmentationID != 0:
+			return fmt.Errorf("segmentation_id of sub_port %s must not be set for %q segmentation_type", portID, segmentationType)
+		case segmentationType != trunkSegmentationTypeInherit && segmentationID == 0:
+			return fmt.Errorf("segmentation_id of sub_port %s must be set for %q segmentation_type", portID, segmentationType)
+		}
+	}
+	return nil
+}
+
+type trunkExtended struct {
+	trunks.Trunk
+	networking.SDNExt
+}
+
+func resourceNetworkingTrunkStateRefreshFunc(client *gophercloud.ServiceClient, trunkID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		t, err := iports.GetTrunk(client, trunkID).Extract()
+		if err != nil {
+			if errutil.IsNotFound(err) {
+				return t, "DELETED", nil
+			}
+
+			return t, "", err
+		}
+
+		if t.Status == "DEGRADED" || t.Status == "ERROR" {
+			return t, t.Status, fmt.Errorf("vkcs_networking_trunk %s went into %s status", trunkID, t.Status)
+		}
+
+		return t, t.Status, nil
+	}
+}
+
+// networkingTrunkParentPortSDN returns SDN of the trunk parent port, so the
+// trunk is created in the same SDN as the port.
+func networkingTrunkParentPortSDN(client *gophercloud.ServiceClient, portID string) (string, error) {
+	var port portExtended
+	err := iports.Get(client, portID).ExtractInto(&port)
+	if err != nil {
+		return "", err
+	}
+
+	return port.SDN, nil
+}
+
+func expandNetworkingTrunkSubports(subports *schema.Set) []iports.TrunkSubport {
+	result := make([]iports.TrunkSubport, 0, subports.Len())
+	for _, v := range subports.List() {
+		m := v.(map[string]interface{})
+		result = append(result, iports.TrunkSubport{
+			PortID:           m["port_id"].(string),
+			SegmentationType: m["segmentation_type"].(string),
+			SegmentationID:   m["segmentation_id"].(int),
+		})
+	}
+	return result
+}
+
+func expandNetworkingTrunkSubportsRemove(subports *schema.Set) []trunks.RemoveSubport {
+	result := make([]trunks.RemoveSubport, 0, subports.Len())
+	for _, v := range subports.List() {
+		m := v.(map[string]interface{})
+		result = append(result, trunks.RemoveSubport{
+			PortID: m["port_id"].(string),
+		})
+	}
+	return result
+}
+
+func flattenNetworkingTrunkSubports(subports []trunks.Subport) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(subports))
+	for _, s := range subports {
+		segmentationID := s.SegmentationID
+		// Sub-ports with "inherit" segmentation report the ID of the
+		// network segment, which is not set by the user.
+		if s.SegmentationType == trunkSegmentationTypeInherit {
+			segmentationID = 0
+		}
+		result = append(result, map[string]interface{}{
+			"port_id":           s.PortID,
+			"segmentation_type": s.SegmentationType,
+			"segmentation_id":   segmentationID,
+		})
+	}
+	return result
+}
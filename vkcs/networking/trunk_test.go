@@ -0,0 +1,91 @@
+package networking
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/trunks"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	iports "github.com/vk-cs/terraform-provider-vkcs/vkcs/internal/services/networking/v2/ports"
+)
+
+func TestExpandNetworkingTrunkSubports(t *testing.T) {
+	r := ResourceNetworkingTrunk()
+	d := r.TestResourceData()
+	d.Set("sub_port", []map[string]interface{}{
+		{
+			"port_id":           "port_1",
+			"segmentation_type": "vlan",
+			"segmentation_id":   100,
+		},
+	})
+
+	expected := []iports.TrunkSubport{
+		{
+			PortID:           "port_1",
+			SegmentationType: "vlan",
+			SegmentationID:   100,
+		},
+	}
+
+	subports := d.Get("sub_port").(*schema.Set)
+	assert.Equal(t, expected, expandNetworkingTrunkSubports(subports))
+	assert.Equal(t, []trunks.RemoveSubport{{PortID: "port_1"}}, expandNetworkingTrunkSubportsRemove(subports))
+}
+
+func TestFlattenNetworkingTrunkSubports(t *testing.T) {
+	subports := []trunks.Subport{
+		{
+			PortID:           "port_1",
+			SegmentationType: "vlan",
+			SegmentationID:   100,
+		},
+		{
+			PortID:           "port_2",
+			SegmentationType: "inherit",
+			SegmentationID:   200,
+		},
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"port_id":           "port_1",
+			"segmentation_type": "vlan",
+			"segmentation_id":   100,
+		},
+		{
+			"port_id":           "port_2",
+			"segmentation_type": "inherit",
+			"segmentation_id":   0,
+		},
+	}
+
+	assert.Equal(t, expected, flattenNetworkingTrunkSubports(subports))
+}
+
+func TestCheckNetworkingTrunkSubports(t *testing.T) {
+	cases := []struct {
+		name     string
+		subport  map[string]interface{}
+		hasError bool
+	}{
+		{"vlan with id", map[string]interface{}{"port_id": "port_1", "segmentation_type": "vlan", "segmentation_id": 100}, false},
+		{"vlan without id", map[string]interface{}{"port_id": "port_1", "segmentation_type": "vlan"}, true},
+		{"inherit without id", map[string]interface{}{"port_id": "port_1", "segmentation_type": "inherit"}, false},
+		{"inherit with id", map[string]interface{}{"port_id": "port_1", "segmentation_type": "inherit", "segmentation_id": 100}, true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			d := ResourceNetworkingTrunk().TestResourceData()
+			d.Set("sub_port", []map[string]interface{}{c.subport})
+
+			err := checkNetworkingTrunkSubports(d.Get("sub_port").(*schema.Set))
+			if c.hasError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -319,10 +319,13 @@ func (p *vkcsProvider) DataSources(_ context.Context) []func() datasource.DataSo
 		db.NewDatastoresDataSource,
 		db.NewDatastoreCapabilitiesDataSource,
 		db.NewDatastoreParametersDataSource,
+		db.NewInstanceLogDataSource,
+		db.NewInstanceLogsDataSource,
 		dc.NewAPIOptionsDataSource,
 		iam.NewServiceUserDataSource,
 		iam.NewS3AccountDataSource,
 		images.NewImagesDataSource,
+		images.NewImageMembersDataSource,
 		keymanager.NewContainerDataSource,
 		keymanager.NewSecretDataSource,
 		kubernetes.NewAddonDatasource,
@@ -337,6 +340,7 @@ func (p *vkcsProvider) DataSources(_ context.Context) []func() datasource.DataSo
 		kubernetes.NewSecurityPolicyTemplatesDataSource,
 		networking.NewPortDataSource,
 		networking.NewSubnetDataSource,
+		networking.NewSubnetPoolDataSource,
 	}
 }
 
@@ -213,6 +213,10 @@ func SDKProviderBase() *sdkschema.Provider {
 			"vkcs_blockstorage_volume":           blockstorage.DataSourceBlockStorageVolume(),
 			"vkcs_blockstorage_snapshot":         blockstorage.DataSourceBlockStorageSnapshot(),
 			"vkcs_lb_loadbalancer":               lb.DataSourceLoadBalancer(),
+			"vkcs_lb_loadbalancer_status":        lb.DataSourceLoadBalancerStatus(),
+			"vkcs_lb_flavors":                    lb.DataSourceFlavors(),
+			"vkcs_lb_availability_zones":         lb.DataSourceAvailabilityZones(),
+			"vkcs_lb_listener_stats":             lb.DataSourceListenerStats(),
 			"vkcs_sharedfilesystem_sharenetwork": sharedfilesystem.DataSourceSharedFilesystemShareNetwork(),
 			"vkcs_sharedfilesystem_share":        sharedfilesystem.DataSourceSharedFilesystemShare(),
 			"vkcs_db_database":                   db.DataSourceDatabaseDatabase(),
@@ -226,56 +230,68 @@ func SDKProviderBase() *sdkschema.Provider {
 		},
 
 		ResourcesMap: map[string]*sdkschema.Resource{
-			"vkcs_compute_instance":                   compute.ResourceComputeInstance(),
-			"vkcs_compute_interface_attach":           compute.ResourceComputeInterfaceAttach(),
-			"vkcs_compute_keypair":                    compute.ResourceComputeKeypair(),
-			"vkcs_compute_volume_attach":              compute.ResourceComputeVolumeAttach(),
-			"vkcs_compute_floatingip_associate":       compute.ResourceComputeFloatingIPAssociate(),
-			"vkcs_compute_servergroup":                compute.ResourceComputeServerGroup(),
-			"vkcs_images_image":                       images.ResourceImagesImage(),
-			"vkcs_networking_network":                 networking.ResourceNetworkingNetwork(),
-			"vkcs_networking_subnet":                  networking.ResourceNetworkingSubnet(),
-			"vkcs_networking_subnet_route":            networking.ResourceNetworkingSubnetRoute(),
-			"vkcs_networking_router":                  networking.ResourceNetworkingRouter(),
-			"vkcs_networking_router_interface":        networking.ResourceNetworkingRouterInterface(),
-			"vkcs_networking_router_route":            networking.ResourceNetworkingRouterRoute(),
-			"vkcs_networking_port":                    networking.ResourceNetworkingPort(),
-			"vkcs_networking_port_secgroup_associate": networking.ResourceNetworkingPortSecGroupAssociate(),
-			"vkcs_networking_secgroup":                firewall.ResourceNetworkingSecGroup(),
-			"vkcs_networking_secgroup_rule":           firewall.ResourceNetworkingSecGroupRule(),
-			"vkcs_networking_floatingip":              networking.ResourceNetworkingFloating(),
-			"vkcs_networking_floatingip_associate":    networking.ResourceNetworkingFloatingIPAssociate(),
-			"vkcs_keymanager_secret":                  keymanager.ResourceKeyManagerSecret(),
-			"vkcs_keymanager_container":               keymanager.ResourceKeyManagerContainer(),
-			"vkcs_blockstorage_volume":                blockstorage.ResourceBlockStorageVolume(),
-			"vkcs_blockstorage_snapshot":              blockstorage.ResourceBlockStorageSnapshot(),
-			"vkcs_lb_l7policy":                        lb.ResourceL7Policy(),
-			"vkcs_lb_l7rule":                          lb.ResourceL7Rule(),
-			"vkcs_lb_listener":                        lb.ResourceListener(),
-			"vkcs_lb_loadbalancer":                    lb.ResourceLoadBalancer(),
-			"vkcs_lb_member":                          lb.ResourceMember(),
-			"vkcs_lb_members":                         lb.ResourceMembers(),
-			"vkcs_lb_monitor":                         lb.ResourceMonitor(),
-			"vkcs_lb_pool":                            lb.ResourcePool(),
-			"vkcs_vpnaas_endpoint_group":              vpnaas.ResourceEndpointGroup(),
-			"vkcs_vpnaas_ike_policy":                  vpnaas.ResourceIKEPolicy(),
-			"vkcs_vpnaas_ipsec_policy":                vpnaas.ResourceIPSecPolicy(),
-			"vkcs_vpnaas_service":                     vpnaas.ResourceService(),
-			"vkcs_vpnaas_site_connection":             vpnaas.ResourceSiteConnection(),
-			"vkcs_sharedfilesystem_securityservice":   sharedfilesystem.ResourceSharedFilesystemSecurityService(),
-			"vkcs_sharedfilesystem_sharenetwork":      sharedfilesystem.ResourceSharedFilesystemShareNetwork(),
-			"vkcs_sharedfilesystem_share":             sharedfilesystem.ResourceSharedFilesystemShare(),
-			"vkcs_sharedfilesystem_share_access":      sharedfilesystem.ResourceSharedFilesystemShareAccess(),
-			"vkcs_db_instance":                        db.ResourceDatabaseInstance(),
-			"vkcs_db_database":                        db.ResourceDatabaseDatabase(),
-			"vkcs_db_user":                            db.ResourceDatabaseUser(),
-			"vkcs_db_cluster":                         db.ResourceDatabaseCluster(),
-			"vkcs_db_cluster_with_shards":             db.ResourceDatabaseClusterWithShards(),
-			"vkcs_db_config_group":                    db.ResourceDatabaseConfigGroup(),
-			"vkcs_kubernetes_cluster":                 kubernetes.ResourceKubernetesCluster(),
-			"vkcs_kubernetes_node_group":              kubernetes.ResourceKubernetesNodeGroup(),
-			"vkcs_publicdns_zone":                     publicdns.ResourcePublicDNSZone(),
-			"vkcs_publicdns_record":                   publicdns.ResourcePublicDNSRecord(),
+			"vkcs_compute_instance":                      compute.ResourceComputeInstance(),
+			"vkcs_compute_interface_attach":              compute.ResourceComputeInterfaceAttach(),
+			"vkcs_compute_keypair":                       compute.ResourceComputeKeypair(),
+			"vkcs_compute_volume_attach":                 compute.ResourceComputeVolumeAttach(),
+			"vkcs_compute_floatingip_associate":          compute.ResourceComputeFloatingIPAssociate(),
+			"vkcs_compute_servergroup":                   compute.ResourceComputeServerGroup(),
+			"vkcs_images_image":                          images.ResourceImagesImage(),
+			"vkcs_images_image_member":                   images.ResourceImagesImageMember(),
+			"vkcs_images_image_member_accept":            images.ResourceImagesImageMemberAccept(),
+			"vkcs_networking_network":                    networking.ResourceNetworkingNetwork(),
+			"vkcs_networking_subnet":                     networking.ResourceNetworkingSubnet(),
+			"vkcs_networking_subnet_route":               networking.ResourceNetworkingSubnetRoute(),
+			"vkcs_networking_subnetpool":                 networking.ResourceNetworkingSubnetPool(),
+			"vkcs_networking_router":                     networking.ResourceNetworkingRouter(),
+			"vkcs_networking_router_interface":           networking.ResourceNetworkingRouterInterface(),
+			"vkcs_networking_router_route":               networking.ResourceNetworkingRouterRoute(),
+			"vkcs_networking_port":                       networking.ResourceNetworkingPort(),
+			"vkcs_networking_port_secgroup_associate":    networking.ResourceNetworkingPortSecGroupAssociate(),
+			"vkcs_networking_secgroup":                   firewall.ResourceNetworkingSecGroup(),
+			"vkcs_networking_secgroup_rule":              firewall.ResourceNetworkingSecGroupRule(),
+			"vkcs_networking_floatingip":                 networking.ResourceNetworkingFloating(),
+			"vkcs_networking_floatingip_associate":       networking.ResourceNetworkingFloatingIPAssociate(),
+			"vkcs_networking_floatingip_port_forwarding": networking.ResourceNetworkingFloatingIPPortForwarding(),
+			"vkcs_networking_qos_policy":                 networking.ResourceNetworkingQoSPolicy(),
+			"vkcs_networking_qos_bandwidth_limit_rule":   networking.ResourceNetworkingQoSBandwidthLimitRule(),
+			"vkcs_networking_qos_dscp_marking_rule":      networking.ResourceNetworkingQoSDSCPMarkingRule(),
+			"vkcs_networking_rbac_policy":                networking.ResourceNetworkingRBACPolicy(),
+			"vkcs_networking_trunk":                      networking.ResourceNetworkingTrunk(),
+			"vkcs_keymanager_secret":                     keymanager.ResourceKeyManagerSecret(),
+			"vkcs_keymanager_container":                  keymanager.ResourceKeyManagerContainer(),
+			"vkcs_blockstorage_volume":                   blockstorage.ResourceBlockStorageVolume(),
+			"vkcs_blockstorage_snapshot":                 blockstorage.ResourceBlockStorageSnapshot(),
+			"vkcs_blockstorage_volume_transfer":          blockstorage.ResourceBlockStorageVolumeTransfer(),
+			"vkcs_blockstorage_volume_transfer_accept":   blockstorage.ResourceBlockStorageVolumeTransferAccept(),
+			"vkcs_lb_l7policy":                           lb.ResourceL7Policy(),
+			"vkcs_lb_l7rule":                             lb.ResourceL7Rule(),
+			"vkcs_lb_listener":                           lb.ResourceListener(),
+			"vkcs_lb_loadbalancer":                       lb.ResourceLoadBalancer(),
+			"vkcs_lb_member":                             lb.ResourceMember(),
+			"vkcs_lb_members":                            lb.ResourceMembers(),
+			"vkcs_lb_monitor":                            lb.ResourceMonitor(),
+			"vkcs_lb_pool":                               lb.ResourcePool(),
+			"vkcs_vpnaas_endpoint_group":                 vpnaas.ResourceEndpointGroup(),
+			"vkcs_vpnaas_ike_policy":                     vpnaas.ResourceIKEPolicy(),
+			"vkcs_vpnaas_ipsec_policy":                   vpnaas.ResourceIPSecPolicy(),
+			"vkcs_vpnaas_service":                        vpnaas.ResourceService(),
+			"vkcs_vpnaas_site_connection":                vpnaas.ResourceSiteConnection(),
+			"vkcs_sharedfilesystem_securityservice":      sharedfilesystem.ResourceSharedFilesystemSecurityService(),
+			"vkcs_sharedfilesystem_sharenetwork":         sharedfilesystem.ResourceSharedFilesystemShareNetwork(),
+			"vkcs_sharedfilesystem_share":                sharedfilesystem.ResourceSharedFilesystemShare(),
+			"vkcs_sharedfilesystem_share_access":         sharedfilesystem.ResourceSharedFilesystemShareAccess(),
+			"vkcs_db_instance":                           db.ResourceDatabaseInstance(),
+			"vkcs_db_database":                           db.ResourceDatabaseDatabase(),
+			"vkcs_db_user":                               db.ResourceDatabaseUser(),
+			"vkcs_db_cluster":                            db.ResourceDatabaseCluster(),
+			"vkcs_db_cluster_with_shards":                db.ResourceDatabaseClusterWithShards(),
+			"vkcs_db_config_group":                       db.ResourceDatabaseConfigGroup(),
+			"vkcs_db_replica":                            db.ResourceDatabaseReplica(),
+			"vkcs_kubernetes_cluster":                    kubernetes.ResourceKubernetesCluster(),
+			"vkcs_kubernetes_node_group":                 kubernetes.ResourceKubernetesNodeGroup(),
+			"vkcs_publicdns_zone":                        publicdns.ResourcePublicDNSZone(),
+			"vkcs_publicdns_record":                      publicdns.ResourcePublicDNSRecord(),
 		},
 	}
 